@@ -0,0 +1,54 @@
+package client
+
+import (
+	"github.com/ftl/tci/client/internal/registry"
+)
+
+// MinVersion returns the minimum TCI protocol version that supports the command or message with the given name.
+// The listeners for a message have the same minimum protocol version as the message.
+// Unknown commands and messages are assumed to be supported since TCI 1.4.
+func MinVersion(command string) Version {
+	entry, ok := registry.Lookup(command)
+	if !ok {
+		return tci_1_4
+	}
+	return Version(entry.Version())
+}
+
+// ProtocolVersion returns the TCI protocol version that is used by the server. Until the server sent its PROTOCOL
+// message, TCI 1.4 is assumed.
+// The version as it was sent by the server is available in the ProtocolVersion field of the DeviceInfo.
+func (c *Client) ProtocolVersion() Version {
+	return c.version()
+}
+
+func (n *notifier) version() Version {
+	n.versionLock.RLock()
+	defer n.versionLock.RUnlock()
+	return n.tciVersion
+}
+
+// Supports indicates if the command or message with the given name is supported by the TCI protocol version that
+// is used by the server.
+func (n *notifier) Supports(command string) bool {
+	return n.version().AtLeast(MinVersion(command))
+}
+
+// checkVersion returns an *UnsupportedCommandError if the given version is required and not supported by the server.
+func (n *notifier) checkVersion(command string, required Version) error {
+	version := n.version()
+	if !version.AtLeast(required) {
+		return &UnsupportedCommandError{Command: command, ProtocolVersion: version, RequiredVersion: required}
+	}
+	return nil
+}
+
+// checkCommand returns an *UnsupportedCommandError if the command with the given name is not supported by the server.
+// The minimum version of unverified commands is not enforced, they are always sent.
+func (n *notifier) checkCommand(command string) error {
+	entry, ok := registry.Lookup(command)
+	if ok && !entry.Verified() {
+		return nil
+	}
+	return n.checkVersion(command, MinVersion(command))
+}
@@ -0,0 +1,61 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ftl/tci/client"
+	"github.com/ftl/tci/client/clienttest"
+)
+
+func TestMinVersion(t *testing.T) {
+	assert.Equal(t, client.Version(1.4), client.MinVersion("vfo"))
+	assert.Equal(t, client.Version(1.5), client.MinVersion("rx_sensors_enable"))
+	assert.Equal(t, client.Version(1.6), client.MinVersion("RX_NB_ENABLE"))
+	assert.Equal(t, client.Version(1.8), client.MinVersion("agc_mode"))
+	assert.Equal(t, client.Version(1.9), client.MinVersion("vfo_lock"))
+}
+
+func TestSupports(t *testing.T) {
+	handshake := clienttest.DefaultHandshake()
+	handshake[0] = client.NewCommandMessage("protocol", "ExpertSDR3", "1.5")
+	server := clienttest.NewServer(handshake...)
+	defer server.Close()
+	c, err := client.OpenURL(server.URL())
+	require.NoError(t, err)
+	defer c.Disconnect()
+
+	assert.Equal(t, client.Version(1.5), c.ProtocolVersion())
+	assert.True(t, c.Supports("vfo"))
+	assert.True(t, c.Supports("tx_sensors_enable"))
+	assert.False(t, c.Supports("rx_nb_enable"))
+
+	assert.ErrorIs(t, c.SetRXNBEnable(0, true), client.ErrUnsupported)
+	_, err = c.AudioStreamChannels()
+	assert.ErrorIs(t, err, client.ErrUnsupported)
+	assert.NoError(t, c.SetTXSensorsEnable(true, 500))
+	assert.Len(t, server.Received(), 1)
+}
+
+func TestSupports_UnverifiedCommands(t *testing.T) {
+	handshake := clienttest.DefaultHandshake()
+	handshake[0] = client.NewCommandMessage("protocol", "ExpertSDR3", "1.8")
+	server := clienttest.NewServer(handshake...)
+	defer server.Close()
+	c, err := client.OpenURL(server.URL())
+	require.NoError(t, err)
+	defer c.Disconnect()
+
+	assert.False(t, c.Supports("vfo_lock"))
+	assert.NoError(t, c.SetAGCMode(0, client.AGCModeFast))
+	assert.NoError(t, c.SetMonitorVolume(-70))
+	assert.NoError(t, c.SetVFOLock(0, client.VFOA, true))
+	assert.NoError(t, c.SetTXStreamAudioBuffering(1000))
+	assert.Len(t, server.Received(), 4)
+
+	mode, err := c.AGCMode(0)
+	assert.NoError(t, err)
+	assert.Equal(t, client.AGCModeFast, mode)
+}
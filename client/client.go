@@ -3,13 +3,21 @@ The package client provides a client implementation for the TCI protocol.
 */
 package client
 
+//go:generate go run ./internal/gen
+
 import (
+	"bytes"
+	"context"
 	"errors"
 	"fmt"
-	"log"
+	"io"
 	"net"
+	"net/http"
 	"net/url"
+	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -21,6 +29,9 @@ const DefaultPort = 40001
 // DefaultTimeout is the default duration to wait for the reply of a command.
 var DefaultTimeout = time.Duration(50 * time.Millisecond)
 
+// DefaultPipelineDepth is the default number of commands that may be sent without waiting for the previous replies.
+const DefaultPipelineDepth = 1
+
 // ErrTimeout indicates a timeout while waiting for the reply of a command.
 var ErrTimeout = errors.New("timeout")
 
@@ -42,25 +53,72 @@ func (f ConnectionListenerFunc) Connected(connected bool) {
 
 // Client represents a TCI client.
 type Client struct {
-	DeviceInfo
 	*notifier
-	host           *net.TCPAddr
-	closed         chan struct{}
+	device          deviceInfoCollector
+	address         string
+	dialer          websocket.Dialer
+	requestHeader   http.Header
+	reconnectPolicy ReconnectPolicy
+	resync          resyncState
+	resyncHook      ResyncHook
+	recorder        *Recorder
+	strictCommands  bool
+	deviceLimits    bool
+	// initialListeners are registered after all options are applied, see WithListeners.
+	initialListeners []interface{}
+	dial             func() (clientConn, error)
+	closed           chan struct{}
+	sessionLock      sync.RWMutex
+	session          *session
+	timeout          atomic.Int64
+	pipelineDepth    atomic.Int32
+	trace            bool
+}
+
+// session holds the state of a single TCI connection.
+type session struct {
 	ready          chan struct{}
-	disconnectChan chan struct{}
+	disconnected   chan struct{}
+	disconnectOnce sync.Once
 	commands       chan command
 	txAudio        chan []byte
-	timeout        time.Duration
-	trace          bool
+	// canceled wakes up the write loop when the context of a pending command is canceled.
+	canceled chan struct{}
+}
+
+func newSession() *session {
+	return &session{
+		ready:        make(chan struct{}),
+		disconnected: make(chan struct{}),
+		commands:     make(chan command, commandQueueSize),
+		txAudio:      make(chan []byte, txAudioQueueSize),
+		canceled:     make(chan struct{}, 1),
+	}
+}
+
+func (s *session) close() {
+	s.disconnectOnce.Do(func() {
+		close(s.disconnected)
+	})
+}
+
+func (s *session) isClosed() bool {
+	select {
+	case <-s.disconnected:
+		return true
+	default:
+		return false
+	}
 }
 
 const (
-	commandQueueSize = 1
+	commandQueueSize = 16
 	txAudioQueueSize = 25
 )
 
 type command struct {
 	Message
+	ctx   context.Context
 	reply chan reply
 }
 
@@ -76,23 +134,52 @@ type clientConn interface {
 	ReadMessage() (messageType int, p []byte, err error)
 }
 
-func newClient(host *net.TCPAddr, trace bool, listeners []interface{}) *Client {
+func newClient(address string, options []Option) *Client {
 	result := &Client{
-		host:    host,
-		closed:  make(chan struct{}),
-		ready:   make(chan struct{}),
-		timeout: DefaultTimeout,
-		trace:   trace,
-	}
-	result.notifier = newNotifier(listeners, result.closed)
-	result.Notify(result)
+		address:         address,
+		closed:          make(chan struct{}),
+		dialer:          *websocket.DefaultDialer,
+		reconnectPolicy: DefaultReconnectPolicy,
+	}
+	result.dial = result.dialWebsocket
+	result.timeout.Store(int64(DefaultTimeout))
+	result.pipelineDepth.Store(DefaultPipelineDepth)
+	result.notifier = newNotifier(nil, result.closed)
+	result.subscribeInline(result)
+	result.subscribeInline(&result.device)
+	for _, option := range options {
+		option(result)
+	}
+	if result.trace && result.logger == defaultLogger {
+		result.logger = defaultTraceLogger
+	}
+	for _, listener := range result.initialListeners {
+		result.Notify(listener)
+	}
 	return result
 }
 
 // Open a connection to the given host. The given listeners are notified about any incoming message.
-// Open returns as soon as the READY; message was received.
+// Open returns as soon as the READY; message was received and all listeners were notified about the handshake.
 func Open(host *net.TCPAddr, trace bool, listeners ...interface{}) (*Client, error) {
-	client := newClient(host, trace, listeners)
+	return open(tcpAddrURL(host), []Option{WithTrace(trace), WithListeners(listeners...)})
+}
+
+// OpenURL opens a connection to the given address, which is either a ws:// or wss:// URL, or a host name
+// with an optional port. If no port is given with a host name, the DefaultPort is used. If no host name is given
+// (e.g. ":40001"), localhost is used. The port must be between 1 and 65535. The host name is resolved again with
+// every connection attempt.
+// OpenURL returns as soon as the READY; message was received.
+func OpenURL(address string, options ...Option) (*Client, error) {
+	u, err := parseAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	return open(u, options)
+}
+
+func open(address string, options []Option) (*Client, error) {
+	client := newClient(address, options)
 	err := client.connect()
 	if err != nil {
 		return nil, err
@@ -104,39 +191,72 @@ func Open(host *net.TCPAddr, trace bool, listeners ...interface{}) (*Client, err
 // KeepOpen opens a connection to the given host and tries to keep an open connection by automatically
 // trying to reconnect when an established connection is lost (after the given grace period). The given
 // listeners are notified about any incoming message.
+// After each reconnect, the streams, sensors, sample rates, and spots that were requested before are restored
+// (see ResyncCommands and WithResyncHook).
 // KeepOpen returns immediately. If you want to know when the connection is available, add a ConnectionListener to the
 // list of listeners.
 func KeepOpen(host *net.TCPAddr, retryInterval time.Duration, trace bool, listeners ...interface{}) *Client {
-	client := newClient(host, trace, listeners)
+	return keepOpen(tcpAddrURL(host), []Option{WithReconnectPolicy(FixedReconnectPolicy(retryInterval)), WithTrace(trace), WithListeners(listeners...)})
+}
+
+// KeepOpenURL opens a connection to the given address like OpenURL and tries to keep an open connection like KeepOpen.
+// The reconnect attempts follow the DefaultReconnectPolicy, unless another policy is configured with WithReconnectPolicy.
+// Failed attempts are reported to all ReconnectListeners.
+// KeepOpenURL returns immediately, an error is only returned if the given address is invalid.
+func KeepOpenURL(address string, options ...Option) (*Client, error) {
+	u, err := parseAddress(address)
+	if err != nil {
+		return nil, err
+	}
+	return keepOpen(u, options), nil
+}
+
+func keepOpen(address string, options []Option) *Client {
+	client := newClient(address, options)
 	go func() {
 		disconnected := make(chan bool, 1)
-		log.Printf("connecting to %s...", host.IP.String())
+		failedAttempts := 0
+		client.logger.Info("connecting", "host", address)
 		for {
+			var delay time.Duration
 			err := client.connect()
 			if err == nil {
+				failedAttempts = 0
 				client.WhenDisconnected(func() {
 					disconnected <- true
 				})
 				select {
 				case <-disconnected:
-					log.Printf("connection lost to %s, waiting for retry", host.IP.String())
+					client.logger.Warn("connection lost, waiting for retry", "host", address)
 				case <-client.closed:
-					log.Printf("connection closed")
+					client.logger.Info("connection closed", "host", address)
 					return
 				}
+				delay = client.reconnectPolicy.Delay(1)
 			} else {
-				if trace {
-					log.Printf("cannot connect to %s, waiting for retry: %v", host.IP.String(), err)
+				failedAttempts++
+				attempt := ReconnectAttempt{
+					Attempt: failedAttempts,
+					Err:     err,
+					GaveUp:  client.reconnectPolicy.GiveUp(failedAttempts),
+				}
+				if !attempt.GaveUp {
+					attempt.NextRetry = client.reconnectPolicy.Delay(failedAttempts)
+				}
+				client.emitReconnectFailed(attempt)
+				if attempt.GaveUp {
+					client.logger.Error("cannot connect, giving up", "host", address, "attempts", failedAttempts, "error", err)
+					return
 				}
+				client.logger.Warn("cannot connect, waiting for retry", "host", address, "attempt", failedAttempts, "next_retry", attempt.NextRetry, "error", err)
+				delay = attempt.NextRetry
 			}
 
 			select {
-			case <-time.After(retryInterval):
-				if trace {
-					log.Printf("retrying to connect to %s", host.IP.String())
-				}
+			case <-time.After(delay):
+				client.logger.Debug("retrying to connect", "host", address)
 			case <-client.closed:
-				log.Print("connection closed")
+				client.logger.Info("connection closed", "host", address)
 				return
 			}
 		}
@@ -144,186 +264,404 @@ func KeepOpen(host *net.TCPAddr, retryInterval time.Duration, trace bool, listen
 	return client
 }
 
-func (c *Client) connect() error {
-	if c.Connected() {
-		return nil
-	}
-
-	host := c.host.IP.String()
-	port := c.host.Port
+func tcpAddrURL(host *net.TCPAddr) string {
+	port := host.Port
 	if port == 0 {
 		port = DefaultPort
 	}
+	u := url.URL{
+		Scheme: "ws",
+		Host:   net.JoinHostPort(host.IP.String(), strconv.Itoa(port)),
+	}
+	return u.String()
+}
+
+// parseAddress returns the websocket URL for the given address, which is either a ws:// or wss:// URL, or a host name with an optional port.
+// If the host name is empty, localhost is used.
+func parseAddress(address string) (string, error) {
+	if strings.Contains(address, "://") {
+		u, err := url.Parse(address)
+		if err != nil {
+			return "", fmt.Errorf("invalid TCI URL %s: %w", address, err)
+		}
+		if u.Scheme != "ws" && u.Scheme != "wss" {
+			return "", fmt.Errorf("invalid TCI URL %s: unsupported scheme %s", address, u.Scheme)
+		}
+		if u.Hostname() == "" {
+			return "", fmt.Errorf("invalid TCI URL %s: no host", address)
+		}
+		if u.Port() != "" {
+			if err := checkPort(u.Port()); err != nil {
+				return "", fmt.Errorf("invalid TCI URL %s: %w", address, err)
+			}
+		}
+		return u.String(), nil
+	}
 
-	u, err := url.Parse(fmt.Sprintf("ws://%s:%d", host, port))
+	host, port, err := net.SplitHostPort(address)
 	if err != nil {
-		return err
+		host = strings.TrimSuffix(strings.TrimPrefix(address, "["), "]")
+		port = ""
+	}
+	if host == "" {
+		host = "localhost"
+	}
+	if port == "" {
+		port = strconv.Itoa(DefaultPort)
+	}
+	if err := checkPort(port); err != nil {
+		return "", fmt.Errorf("invalid TCI address %s: %w", address, err)
+	}
+	u := url.URL{
+		Scheme: "ws",
+		Host:   net.JoinHostPort(host, port),
+	}
+	return u.String(), nil
+}
+
+// checkPort checks that the given port is a number between 1 and 65535.
+func checkPort(port string) error {
+	p, err := strconv.Atoi(port)
+	if err != nil || p < 1 || p > 65535 {
+		return fmt.Errorf("invalid port %s", port)
+	}
+	return nil
+}
+
+func (c *Client) connect() error {
+	if c.Connected() {
+		return nil
 	}
-	u.Port()
 
-	conn, _, err := websocket.DefaultDialer.Dial(u.String(), nil)
+	conn, err := c.dial()
 	if err != nil {
-		return fmt.Errorf("cannot open websocket connection: %w", err)
+		return err
 	}
-	c.ready = make(chan struct{})
-	c.disconnectChan = make(chan struct{})
-	c.commands = make(chan command, commandQueueSize)
-	c.txAudio = make(chan []byte, txAudioQueueSize)
+	if c.recorder != nil {
+		conn = &recordingConn{clientConn: conn, recorder: c.recorder, logger: c.logger}
+	}
+	s := newSession()
+	c.device.reset()
+	c.resetTCIProtocol()
+	c.sessionLock.Lock()
+	c.session = s
+	c.sessionLock.Unlock()
 	remoteAddr := conn.RemoteAddr()
 
 	incoming := make(chan Message, 1)
-	go c.readLoop(conn, incoming)
-	go c.writeLoop(conn, incoming)
+	go c.readLoop(s, conn, incoming)
+	go c.writeLoop(s, conn, incoming)
 
-	<-c.ready
+	select {
+	case <-s.ready:
+	case <-s.disconnected:
+		return fmt.Errorf("connection to %s lost before ready", remoteAddr.String())
+	}
 
-	log.Printf("connected to %s", remoteAddr.String())
+	// the listeners get the complete handshake before they are notified about the connection
+	c.waitForListeners(s.disconnected)
+	c.logger.Info("connected", "host", c.address, "remote_addr", remoteAddr.String())
+	c.resynchronize()
 	c.emitConnected(true)
 	c.WhenDisconnected(func() {
-		log.Printf("disconnected from %s", remoteAddr.String())
+		c.logger.Info("disconnected", "host", c.address, "remote_addr", remoteAddr.String())
 		c.emitConnected(false)
 	})
 
 	return nil
 }
 
+func (c *Client) dialWebsocket() (clientConn, error) {
+	conn, _, err := c.dialer.Dial(c.address, c.requestHeader)
+	if err != nil {
+		return nil, fmt.Errorf("cannot open websocket connection: %w", err)
+	}
+	return conn, nil
+}
+
 func (c *Client) emitConnected(connected bool) {
-	for _, l := range c.listeners {
+	for _, l := range c.listeners() {
 		if listener, ok := l.(ConnectionListener); ok {
 			listener.Connected(connected)
 		}
 	}
 }
 
-func (c *Client) readLoop(conn clientConn, incoming chan<- Message) {
+func (c *Client) readLoop(s *session, conn clientConn, incoming chan<- Message) {
 	defer conn.Close()
 	for {
 		select {
-		case <-c.disconnectChan:
+		case <-s.disconnected:
 			return
 		default:
 			msgType, msg, err := conn.ReadMessage()
 			if err != nil {
-				log.Printf("cannot read next message: %v", err)
-				close(c.disconnectChan)
+				c.logger.Warn("cannot read next message", "host", c.address, "error", err)
+				s.close()
 				return
 			}
 			switch msgType {
 			case websocket.TextMessage:
 				if c.trace {
-					log.Printf("< %s", msg)
+					c.logger.Debug("<", "host", c.address, "message", string(msg))
 				}
-				message, err := ParseTextMessage(string(msg))
-				if err != nil {
-					log.Printf("cannot parse incoming message: %v", err)
-					continue
+				tokenizer := NewTokenizer(bytes.NewReader(msg))
+				for {
+					message, err := tokenizer.Next()
+					if err == io.EOF {
+						break
+					}
+					if err != nil {
+						c.logger.Warn("cannot parse incoming message", "host", c.address, "message", string(msg), "error", err)
+						continue
+					}
+					c.notifier.textMessage(message)
+					incoming <- message
 				}
-				c.notifier.textMessage(message)
-				incoming <- message
 			case websocket.BinaryMessage:
-				// if c.trace {
-				// 	log.Printf("< [BINARY DATA]")
-				// }
-				message, err := ParseBinaryMessage(msg)
+				message, err := c.parseBinaryMessage(msg)
 				if err != nil {
-					log.Printf("cannot parse incoming message: %v", err)
+					c.logger.Warn("cannot parse incoming binary message", "host", c.address, "error", err)
 					continue
 				}
 				c.notifier.binaryMessage(message)
+				message.release()
 			default:
-				log.Printf("unknown message type: %d %v", msgType, msg)
+				c.logger.Warn("unknown websocket message type", "host", c.address, "type", msgType)
 			}
 		}
 	}
 }
 
-func (c *Client) writeLoop(conn clientConn, incoming <-chan Message) {
+// parseBinaryMessage parses an incoming binary message, using a pooled sample buffer if buffer pooling is enabled.
+// The caller must release the resulting message.
+func (c *Client) parseBinaryMessage(b []byte) (BinaryMessage, error) {
+	if c.samplePool == nil {
+		return ParseBinaryMessage(b)
+	}
+	return c.samplePool.parse(b)
+}
+
+func (c *Client) writeLoop(s *session, conn clientConn, incoming <-chan Message) {
 	defer conn.Close()
 
-	var currentCommand *command
-	var currentDeadline time.Time
-	timer := time.NewTimer(c.timeout)
+	var pending []pendingCommand
+	var deferred *command
+	timer := time.NewTimer(c.Timeout())
 	defer timer.Stop()
 
 	for {
-		if currentCommand == nil {
-			select {
-			case msg := <-c.txAudio:
-				// if c.trace {
-				// 	log.Printf("> [TX AUDIO]")
-				// }
-				err := conn.WriteMessage(websocket.BinaryMessage, msg)
-				if err != nil {
-					log.Printf("error writing tx audio: %v", err)
-					continue
-				}
-			case cmd := <-c.commands:
-				now := time.Now()
-				if cmd.reply != nil {
-					currentCommand = &cmd
-					currentDeadline = now.Add(c.timeout)
-				}
-				if c.trace {
-					log.Printf("> %s", cmd)
-				}
-				err := conn.WriteMessage(websocket.TextMessage, []byte(cmd.String()))
-				if err != nil {
-					log.Printf("error writing command %q: %v", cmd, err)
-					continue
-				}
-			case <-incoming:
+		pending = dropCanceledCommands(pending)
+		if deferred != nil && len(pending) == 0 {
+			pending = c.writeCommand(conn, *deferred, pending)
+			deferred = nil
+		}
+
+		var commands <-chan command
+		if deferred == nil && c.canPipeline(pending) {
+			commands = s.commands
+		}
+		var timeout <-chan time.Time
+		if len(pending) > 0 {
+			resetTimer(timer, time.Until(earliestDeadline(pending)))
+			timeout = timer.C
+		}
+
+		select {
+		case <-s.disconnected:
+			return
+		case msg := <-s.txAudio:
+			err := conn.WriteMessage(websocket.BinaryMessage, msg)
+			if err != nil {
+				c.logger.Error("cannot write tx audio", "host", c.address, "error", err)
 				continue
 			}
-		} else {
-			now := time.Now()
-			timer.Reset(currentDeadline.Sub(now))
-			select {
-			case <-c.disconnectChan:
-				return
-			case msg := <-c.txAudio:
-				err := conn.WriteMessage(websocket.BinaryMessage, msg)
-				if err != nil {
-					log.Printf("error writing tx audio: %v", err)
-					continue
-				}
-			case msg := <-incoming:
-				if msg.IsReplyTo(currentCommand.Message) {
-					currentCommand.reply <- reply{Message: msg}
-					currentCommand = nil
-				}
-			case <-timer.C:
-				if currentCommand.responseRequired {
-					currentCommand.reply <- reply{err: ErrTimeout}
+		case cmd := <-commands:
+			if cmd.ctx.Err() != nil {
+				continue
+			}
+			if isSerialCommand(cmd.Message) && len(pending) > 0 {
+				deferred = &cmd
+				continue
+			}
+			pending = c.writeCommand(conn, cmd, pending)
+		case msg := <-incoming:
+			pending = handleReply(msg, pending)
+		case <-s.canceled:
+			// the canceled commands are dropped at the beginning of the loop
+		case now := <-timeout:
+			remaining := pending[:0]
+			for _, p := range pending {
+				if now.Before(p.deadline) {
+					remaining = append(remaining, p)
+				} else if p.responseRequired {
+					p.reply <- reply{err: ErrTimeout}
+				} else if p.mismatch != nil {
+					p.reply <- reply{Message: *p.mismatch, err: &ReplyMismatchError{Sent: p.Message, Received: *p.mismatch}}
+				} else if c.strictCommands {
+					p.reply <- reply{err: ErrNoEcho}
 				} else {
-					currentCommand.reply <- reply{}
+					p.reply <- reply{}
 				}
-				currentCommand = nil
 			}
-			timer.Stop()
+			pending = remaining
+		}
+	}
+}
+
+// handleReply completes the first pending command the given message replies to and returns the remaining pending commands.
+// If a command is echoed with a different value, e.g. because the value was changed at the same time on the radio,
+// the command stays pending until its exact echo arrives. The differing echo is only reported as ReplyMismatchError
+// if no exact echo arrives until the deadline.
+func handleReply(msg Message, pending []pendingCommand) []pendingCommand {
+	for i, p := range pending {
+		if msg.IsReplyTo(p.Message) {
+			p.reply <- reply{Message: msg}
+			return append(pending[:i], pending[i+1:]...)
+		}
+	}
+	for i, p := range pending {
+		if p.responseRequired || !isEchoWithOtherValue(msg, p.Message) {
+			continue
+		}
+		if strings.EqualFold(msg.args[len(msg.args)-1], p.args[len(p.args)-1]) {
+			p.reply <- reply{Message: msg}
+			return append(pending[:i], pending[i+1:]...)
+		}
+		mismatch := msg
+		pending[i].mismatch = &mismatch
+	}
+	return pending
+}
+
+// isEchoWithOtherValue indicates if the given message has the same name and the same leading arguments as the given
+// command, but the last argument (i.e. the value) may differ.
+func isEchoWithOtherValue(msg Message, cmd Message) bool {
+	if msg.name != cmd.name || len(msg.args) != len(cmd.args) || len(cmd.args) == 0 {
+		return false
+	}
+	for i := 0; i < len(cmd.args)-1; i++ {
+		if !strings.EqualFold(msg.args[i], cmd.args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (c *Client) writeCommand(conn clientConn, cmd command, pending []pendingCommand) []pendingCommand {
+	if c.trace {
+		c.logger.Debug(">", "host", c.address, "message", cmd.String())
+	}
+	err := conn.WriteMessage(websocket.TextMessage, []byte(cmd.String()))
+	if err != nil {
+		c.logger.Error("cannot write command", "host", c.address, "name", cmd.Name(), "error", err)
+		cmd.reply <- reply{err: fmt.Errorf("cannot write %s: %v: %w", cmd.Name(), err, ErrDisconnected)}
+		return pending
+	}
+	return append(pending, pendingCommand{
+		command:  cmd,
+		deadline: time.Now().Add(c.Timeout()),
+	})
+}
+
+func (c *Client) canPipeline(pending []pendingCommand) bool {
+	if len(pending) == 0 {
+		return true
+	}
+	if len(pending) >= c.PipelineDepth() {
+		return false
+	}
+	return !isSerialCommand(pending[len(pending)-1].Message)
+}
+
+type pendingCommand struct {
+	command
+	deadline time.Time
+	// mismatch is the latest echo of the command with a different value, if any.
+	mismatch *Message
+}
+
+func dropCanceledCommands(pending []pendingCommand) []pendingCommand {
+	remaining := pending[:0]
+	for _, p := range pending {
+		if p.ctx.Err() == nil {
+			remaining = append(remaining, p)
+		}
+	}
+	return remaining
+}
+
+func earliestDeadline(pending []pendingCommand) time.Time {
+	result := pending[0].deadline
+	for _, p := range pending[1:] {
+		if p.deadline.Before(result) {
+			result = p.deadline
+		}
+	}
+	return result
+}
+
+func resetTimer(timer *time.Timer, d time.Duration) {
+	if !timer.Stop() {
+		select {
+		case <-timer.C:
+		default:
 		}
 	}
+	timer.Reset(d)
+}
+
+// serialCommands are never pipelined: they are only sent when no other command is pending,
+// and no other command is sent until the TCI server confirmed them.
+var serialCommands = map[string]bool{
+	"start":          true,
+	"stop":           true,
+	"trx":            true,
+	"tune":           true,
+	"cw_macros":      true,
+	"cw_msg":         true,
+	"callsign_send":  true,
+	"cw_macros_stop": true,
+	"spot_clear":     true,
+}
+
+func isSerialCommand(msg Message) bool {
+	return serialCommands[msg.name]
 }
 
 // Ready handles the READY; message coming from the TCI host. Should not be called from outside!
 func (c *Client) Ready() {
+	s := c.currentSession()
+	if s == nil {
+		return
+	}
+	c.device.publish()
 	select {
-	case <-c.ready:
+	case <-s.ready:
 	default:
-		close(c.ready)
+		close(s.ready)
 	}
 }
 
+// DeviceInfo returns a copy of the information about the connected device, as it was collected during the
+// handshake until the READY message was received. Before the first READY message, and after a different device
+// was announced during a reconnect, the zero DeviceInfo is returned.
+func (c *Client) DeviceInfo() DeviceInfo {
+	result := c.device.snapshot()
+	result.Modes = append([]Mode(nil), result.Modes...)
+	return result
+}
+
+func (c *Client) currentSession() *session {
+	c.sessionLock.RLock()
+	defer c.sessionLock.RUnlock()
+	return c.session
+}
+
 // Connected indicates if there is currently a TCI connection established.
 func (c *Client) Connected() bool {
-	if c.disconnectChan == nil {
-		return false
-	}
-	select {
-	case <-c.disconnectChan:
-		return false
-	default:
-		return true
-	}
+	s := c.currentSession()
+	return s != nil && !s.isClosed()
 }
 
 // Disconnect the TCI connection. If this client was created using KeepOpen, the automatic
@@ -336,60 +674,108 @@ func (c *Client) Disconnect() {
 		close(c.closed)
 	}
 
-	if c.disconnectChan == nil {
+	s := c.currentSession()
+	if s == nil {
 		return
 	}
-	select {
-	case <-c.disconnectChan:
-		return
-	default:
-		close(c.disconnectChan)
-	}
+	s.close()
 }
 
 // WhenDisconnected calls the given function when the client gets disconnected.
 func (c *Client) WhenDisconnected(f func()) {
-	if c.disconnectChan == nil {
+	s := c.currentSession()
+	if s == nil {
 		f()
 		return
 	}
 	go func() {
-		<-c.disconnectChan
+		<-s.disconnected
 		f()
 	}()
 }
 
-func (c *Client) command(cmd string, args ...interface{}) (Message, error) {
-	return c.send(NewCommandMessage(cmd, args...))
+func (c *Client) command(ctx context.Context, cmd string, args ...interface{}) (Message, error) {
+	if err := c.checkCommand(cmd); err != nil {
+		return Message{}, err
+	}
+	if err := c.checkDeviceLimits(cmd, args, false); err != nil {
+		return Message{}, err
+	}
+	return c.send(ctx, NewCommandMessage(cmd, args...))
+}
+
+func (c *Client) request(ctx context.Context, cmd string, args ...interface{}) (Message, error) {
+	if err := c.checkCommand(cmd); err != nil {
+		return Message{}, err
+	}
+	if err := c.checkDeviceLimits(cmd, args, true); err != nil {
+		return Message{}, err
+	}
+	return c.send(ctx, NewRequestMessage(cmd, args...))
+}
+
+// Send sends the given message to the TCI server and waits for the reply.
+// It returns ErrNotConnected if there is no connection, ErrDisconnected if the connection is lost while waiting,
+// ErrTimeout if no reply to a request arrives in time, an *InvalidArgumentError if the message cannot be represented,
+// and a *ReplyMismatchError if a command is only echoed with a different value until the timeout.
+func (c *Client) Send(message Message) (Message, error) {
+	return c.send(context.Background(), message)
 }
 
-func (c *Client) request(cmd string, args ...interface{}) (Message, error) {
-	return c.send(NewRequestMessage(cmd, args...))
+// SendContext is like Send, but uses the given context.
+func (c *Client) SendContext(ctx context.Context, message Message) (Message, error) {
+	return c.send(ctx, message)
 }
 
-func (c *Client) send(message Message) (Message, error) {
-	if !c.Connected() {
+func (c *Client) send(ctx context.Context, message Message) (Message, error) {
+	if err := message.Err(); err != nil {
+		return Message{}, err
+	}
+	s := c.currentSession()
+	if s == nil || s.isClosed() {
 		return Message{}, ErrNotConnected
 	}
 	replyChan := make(chan reply, 1)
-	c.commands <- command{
+	select {
+	case s.commands <- command{
 		Message: message,
+		ctx:     ctx,
 		reply:   replyChan,
+	}:
+	case <-s.disconnected:
+		return Message{}, ErrNotConnected
+	case <-ctx.Done():
+		return Message{}, ctx.Err()
 	}
-	reply := <-replyChan
 
-	return reply.Message, reply.err
+	select {
+	case reply := <-replyChan:
+		return reply.Message, reply.err
+	case <-s.disconnected:
+		return Message{}, ErrDisconnected
+	case <-ctx.Done():
+		// free the pending slot of the command without waiting for its deadline
+		select {
+		case s.canceled <- struct{}{}:
+		default:
+		}
+		return Message{}, ctx.Err()
+	}
 }
 
 // SendTXAudio sends the given samples as reply to a TXChrono message.
 // The samples need to be in stereo, i.e. channel 1 and channel 2 interleaved.
 func (c *Client) SendTXAudio(trx int, sampleRate AudioSampleRate, samples []float32) error {
+	s := c.currentSession()
+	if s == nil || s.isClosed() {
+		return ErrNotConnected
+	}
 	msg, err := NewTXAudioMessage(trx, sampleRate, samples)
 	if err != nil {
 		return err
 	}
 	select {
-	case c.txAudio <- msg:
+	case s.txAudio <- msg:
 		return nil
 	default:
 		return fmt.Errorf("tx audio queue blocked, samples dropped")
@@ -398,892 +784,439 @@ func (c *Client) SendTXAudio(trx int, sampleRate AudioSampleRate, samples []floa
 
 // SetTimeout sets the duration to wait for the reply to a command.
 func (c *Client) SetTimeout(timeout time.Duration) {
-	c.timeout = timeout
+	c.timeout.Store(int64(timeout))
 }
 
 // Timeout is the duration to wait for the reply to a command.
 func (c *Client) Timeout() time.Duration {
-	return c.timeout
+	return time.Duration(c.timeout.Load())
 }
 
-// Start the SDR operation.
-func (c *Client) Start() error {
-	_, err := c.command("start")
-	return err
+// SetPipelineDepth sets the number of commands that may be sent to the TCI server without waiting
+// for the replies to the previous commands. Commands that change the operation of the TRX (e.g. TX, tune,
+// CW transmission) are never pipelined, they always wait until all previous commands are confirmed.
+func (c *Client) SetPipelineDepth(depth int) {
+	if depth < 1 {
+		depth = 1
+	}
+	c.pipelineDepth.Store(int32(depth))
 }
 
-// Stop the SDR operation.
-func (c *Client) Stop() error {
-	_, err := c.command("stop")
-	return err
+// PipelineDepth is the number of commands that may be sent without waiting for the previous replies.
+func (c *Client) PipelineDepth() int {
+	return int(c.pipelineDepth.Load())
 }
 
 // SendCWMacro sends the given text, which may contain macro characters <. >, and | to change the speed and send abbreviations.
 func (c *Client) SendCWMacro(trx int, text string) error {
-	_, err := c.command("cw_macros", trx, escapeCWText(text))
+	return c.SendCWMacroContext(context.Background(), trx, text)
+}
+
+// SendCWMacroContext is like SendCWMacro, but uses the given context.
+func (c *Client) SendCWMacroContext(ctx context.Context, trx int, text string) error {
+	_, err := c.command(ctx, "cw_macros", trx, cwTextOrPlaceholder(text))
 	return err
 }
 
-func escapeCWText(text string) string {
-	result := strings.ReplaceAll(text, ":", "^")
-	result = strings.ReplaceAll(result, ",", "~")
-	result = strings.ReplaceAll(result, ";", "*")
-	if result == "" {
+// cwTextOrPlaceholder returns the placeholder for an empty CW text. The reserved characters are escaped later by
+// Message.String.
+func cwTextOrPlaceholder(text string) string {
+	if text == "" {
 		return "_"
 	}
-	return result
+	return text
 }
 
 // SendCWMessage sends the given text, allowing to changes the callsign as long as it was not transmitted yet.
 func (c *Client) SendCWMessage(trx int, before string, callsign string, after string) error {
-	_, err := c.command("cw_msg", trx, escapeCWText(before), escapeCWText(callsign), escapeCWText(after))
+	return c.SendCWMessageContext(context.Background(), trx, before, callsign, after)
+}
+
+// SendCWMessageContext is like SendCWMessage, but uses the given context.
+func (c *Client) SendCWMessageContext(ctx context.Context, trx int, before string, callsign string, after string) error {
+	_, err := c.command(ctx, "cw_msg", trx, cwTextOrPlaceholder(before), cwTextOrPlaceholder(callsign), cwTextOrPlaceholder(after))
 	return err
 }
 
 // SendCallsign changes the callsign of a previous SendCWMessage call, if the callsign was not transmitted yet.
 func (c *Client) SendCallsign(callsign string) error {
-	_, err := c.command("callsign_send", escapeCWText(callsign))
-	return err
+	return c.SendCallsignContext(context.Background(), callsign)
 }
 
-// StopCW stops the current CW transmission.
-func (c *Client) StopCW() error {
-	_, err := c.command("cw_macros_stop")
+// SendCallsignContext is like SendCallsign, but uses the given context.
+func (c *Client) SendCallsignContext(ctx context.Context, callsign string) error {
+	_, err := c.command(ctx, "callsign_send", cwTextOrPlaceholder(callsign))
 	return err
 }
 
-// SetCWTerminal enables/disables the terminal mode for CW transmission (since TCI 1.5)
-func (c *Client) SetCWTerminal(enabled bool) error {
-	_, err := c.command("cw_terminal", enabled)
-	return err
+// SetTX enables the TX of the given TRX using the given signal source. Use "" (SignalSourceDefault) if you want to use the default source for the current mode.
+func (c *Client) SetTX(trx int, enabled bool, source SignalSource) error {
+	return c.SetTXContext(context.Background(), trx, enabled, source)
 }
 
-// SetDDS sets the center frequency of the given TRX's panorama.
-func (c *Client) SetDDS(trx int, frequency int) error {
-	_, err := c.command("dds", trx, frequency)
+// SetTXContext is like SetTX, but uses the given context.
+func (c *Client) SetTXContext(ctx context.Context, trx int, enabled bool, source SignalSource) error {
+	var err error
+	if source == SignalSourceDefault {
+		_, err = c.command(ctx, "trx", trx, enabled)
+	} else {
+		_, err = c.command(ctx, "trx", trx, enabled, source)
+	}
 	return err
 }
 
-// DDS reads the center frequency of the given TRX's panorama.
-func (c *Client) DDS(trx int) (int, error) {
-	reply, err := c.request("dds", trx)
-	if err != nil {
-		return 0, err
-	}
-	return reply.ToInt(1)
+// SetDrive sets the output power in percent.
+// Starting from TCI version 1.5, this command affects TRX 0.
+func (c *Client) SetDrive(percent int) error {
+	return c.SetDriveContext(context.Background(), percent)
 }
 
-// SetIF sets the tuning frequency of the given TRX's vfo.
-func (c *Client) SetIF(trx int, vfo VFO, frequency int) error {
-	_, err := c.command("if", trx, vfo, frequency)
+// SetDriveContext is like SetDrive, but uses the given context.
+func (c *Client) SetDriveContext(ctx context.Context, percent int) error {
+	if err := checkRange("drive", "percent", percent, 0, 100); err != nil {
+		return err
+	}
+	if c.version().Beyond(tci_1_4) {
+		return c.SetTRXDriveContext(ctx, 0, percent)
+	}
+	_, err := c.command(ctx, "drive", percent)
 	return err
 }
 
-// IF reads the tuning frequency of the given TRX's vfo.
-func (c *Client) IF(trx int, vfo VFO) (int, error) {
-	reply, err := c.request("if", trx, vfo)
+// Drive reads the output power in percent.
+// Starting from TCI version 1.5, this command affects TRX 0.
+func (c *Client) Drive() (int, error) {
+	return c.DriveContext(context.Background())
+}
+
+// DriveContext is like Drive, but uses the given context.
+func (c *Client) DriveContext(ctx context.Context) (int, error) {
+	if c.version().Beyond(tci_1_4) {
+		return c.TRXDriveContext(ctx, 0)
+	}
+	reply, err := c.request(ctx, "drive")
 	if err != nil {
 		return 0, err
 	}
-	return reply.ToInt(2)
+	return reply.ToInt(0)
 }
 
-// SetRITEnable enables the RIT of the given TRX.
-func (c *Client) SetRITEnable(trx int, enabled bool) error {
-	_, err := c.command("rit_enable", trx, enabled)
-	return err
+// SetTRXDrive sets the output power for a certain TRX in percent. (since TCI 1.5)
+func (c *Client) SetTRXDrive(trx int, percent int) error {
+	return c.SetTRXDriveContext(context.Background(), trx, percent)
 }
 
-// RITEnable reads the RIT enable state of the given TRX.
-func (c *Client) RITEnable(trx int) (bool, error) {
-	reply, err := c.request("rit_enable", trx)
-	if err != nil {
-		return false, err
+// SetTRXDriveContext is like SetTRXDrive, but uses the given context.
+func (c *Client) SetTRXDriveContext(ctx context.Context, trx int, percent int) error {
+	if err := checkRange("drive", "percent", percent, 0, 100); err != nil {
+		return err
+	}
+	if err := c.checkVersion("SetTRXDrive", tci_1_5); err != nil {
+		return err
 	}
-	return reply.ToBool(1)
+	_, err := c.command(ctx, "drive", trx, percent)
+	return err
 }
 
-// SetMode sets the mode of the given TRX.
-func (c *Client) SetMode(trx int, mode Mode) error {
-	_, err := c.command("modulation", trx, mode)
-	return err
+// TRXDrive reads the output power of a certain TRX in percent. (since TCI 1.5)
+func (c *Client) TRXDrive(trx int) (int, error) {
+	return c.TRXDriveContext(context.Background(), trx)
 }
 
-// Mode reads the mode of the given TRX.
-func (c *Client) Mode(trx int) (Mode, error) {
-	reply, err := c.request("modulation", trx)
-	if err != nil {
-		return "", err
+// TRXDriveContext is like TRXDrive, but uses the given context.
+func (c *Client) TRXDriveContext(ctx context.Context, trx int) (int, error) {
+	if err := c.checkVersion("TRXDrive", tci_1_5); err != nil {
+		return 0, err
 	}
-	mode, err := reply.ToString(1)
+	reply, err := c.request(ctx, "drive", trx)
 	if err != nil {
-		return "", err
+		return 0, err
 	}
-	mode = strings.ToLower(mode)
-	return Mode(mode), nil
+	return reply.ToInt(1)
 }
 
-// SetRXEnable enables the RX of the given TRX.
-func (c *Client) SetRXEnable(trx int, enabled bool) error {
-	_, err := c.command("rx_enable", trx, enabled)
-	return err
+// SetTuneDrive sets the output power for tuning in percent.
+// Starting from TCI version 1.5, this command affects TRX 0.
+func (c *Client) SetTuneDrive(percent int) error {
+	return c.SetTuneDriveContext(context.Background(), percent)
 }
 
-// RXEnable reads the RX enable state of the given TRX.
-func (c *Client) RXEnable(trx int) (bool, error) {
-	reply, err := c.request("rx_enable", trx)
-	if err != nil {
-		return false, err
+// SetTuneDriveContext is like SetTuneDrive, but uses the given context.
+func (c *Client) SetTuneDriveContext(ctx context.Context, percent int) error {
+	if err := checkRange("tune_drive", "percent", percent, 0, 100); err != nil {
+		return err
+	}
+	if c.version().Beyond(tci_1_4) {
+		return c.SetTRXTuneDriveContext(ctx, 0, percent)
 	}
-	return reply.ToBool(1)
+	_, err := c.command(ctx, "tune_drive", percent)
+	return err
 }
 
-// SetXITEnable enables the XIT of the given TRX.
-func (c *Client) SetXITEnable(trx int, enabled bool) error {
-	_, err := c.command("xit_enable", trx, enabled)
-	return err
+// TuneDrive reads the output power for tuning in percent.
+// Starting from TCI version 1.5, this command affects TRX 0.
+func (c *Client) TuneDrive() (int, error) {
+	return c.TuneDriveContext(context.Background())
 }
 
-// XITEnable reads the XIT enable state of the given TRX.
-func (c *Client) XITEnable(trx int) (bool, error) {
-	reply, err := c.request("xit_enable", trx)
+// TuneDriveContext is like TuneDrive, but uses the given context.
+func (c *Client) TuneDriveContext(ctx context.Context) (int, error) {
+	if c.version().Beyond(tci_1_4) {
+		return c.TRXTuneDriveContext(ctx, 0)
+	}
+	reply, err := c.request(ctx, "tune_drive")
 	if err != nil {
-		return false, err
+		return 0, err
 	}
-	return reply.ToBool(1)
+	return reply.ToInt(0)
 }
 
-// SetSplitEnable enables the split mode of the given TRX. When split mode is enabled, VFOB is used for transmitting.
-func (c *Client) SetSplitEnable(trx int, enabled bool) error {
-	_, err := c.command("split_enable", trx, enabled)
-	return err
+// SetTRXTuneDrive sets the output power for tuning for a certain TRX in percent. (since TCI 1.5)
+func (c *Client) SetTRXTuneDrive(trx int, percent int) error {
+	return c.SetTRXTuneDriveContext(context.Background(), trx, percent)
 }
 
-// SplitEnable reads the split mode enable state of the given TRX. When split mode is enabled, VFOB is used for transmitting.
-func (c *Client) SplitEnable(trx int) (bool, error) {
-	reply, err := c.request("split_enable", trx)
-	if err != nil {
-		return false, err
+// SetTRXTuneDriveContext is like SetTRXTuneDrive, but uses the given context.
+func (c *Client) SetTRXTuneDriveContext(ctx context.Context, trx int, percent int) error {
+	if err := checkRange("tune_drive", "percent", percent, 0, 100); err != nil {
+		return err
+	}
+	if err := c.checkVersion("SetTRXTuneDrive", tci_1_5); err != nil {
+		return err
 	}
-	return reply.ToBool(1)
+	_, err := c.command(ctx, "tune_drive", trx, percent)
+	return err
 }
 
-// SetRITOffset sets the RIT offset in Hz for the given TRX.
-func (c *Client) SetRITOffset(trx int, offset int) error {
-	_, err := c.command("rit_offset", trx, offset)
-	return err
-}
-
-// RITOffset reads the RIT offset in Hz for the given TRX.
-func (c *Client) RITOffset(trx int) (int, error) {
-	reply, err := c.request("rit_offset", trx)
-	if err != nil {
-		return 0, err
-	}
-	return reply.ToInt(1)
-}
-
-// SetXITOffset sets the XIT offset in Hz for the given TRX.
-func (c *Client) SetXITOffset(trx int, offset int) error {
-	_, err := c.command("xit_offset", trx, offset)
-	return err
-}
-
-// XITOffset reads the XIT offset in Hz for the given TRX.
-func (c *Client) XITOffset(trx int) (int, error) {
-	reply, err := c.request("xit_offset", trx)
-	if err != nil {
-		return 0, err
-	}
-	return reply.ToInt(1)
-}
-
-// SetRXChannelEnable enables the given TRX's additional RX channel with the given index.
-func (c *Client) SetRXChannelEnable(trx int, vfo VFO, enabled bool) error {
-	_, err := c.command("rx_channel_enable", trx, vfo, enabled)
-	return err
-}
-
-// RXChannelEnable reads the enable state of the given TRX's additional RX channel with the given index.
-func (c *Client) RXChannelEnable(trx int, vfo VFO) (bool, error) {
-	reply, err := c.request("rx_channel_enable", trx, vfo)
-	if err != nil {
-		return false, err
-	}
-	return reply.ToBool(2)
-}
-
-// SetRXFilterBand sets the IF filter boundaries of the given TRX using the given limit frequencies in Hz.
-func (c *Client) SetRXFilterBand(trx int, min, max int) error {
-	_, err := c.command("rx_filter_band", trx, min, max)
-	return err
-}
-
-// RXFilterBand reads the IF filter boundaries of the given TRX.
-func (c *Client) RXFilterBand(trx int) (int, int, error) {
-	reply, err := c.request("rx_filter_band")
-	if err != nil {
-		return 0, 0, err
-	}
-	min, err := reply.ToInt(1)
-	if err != nil {
-		return 0, 0, err
-	}
-	max, err := reply.ToInt(2)
-	if err != nil {
-		return 0, 0, err
-	}
-	return min, max, nil
-}
-
-// SetRXSMeter sets the signal level of the given TRX's RX channel with the given index.
-func (c *Client) SetRXSMeter(trx int, vfo VFO, level int) error {
-	_, err := c.command("rx_smeter", trx, vfo, level)
-	return err
-}
-
-// RXSMeter reads the signal level of the given TRX's RX channel with the given index.
-func (c *Client) RXSMeter(trx int, vfo VFO) (int, error) {
-	reply, err := c.request("rx_smeter", trx, vfo)
-	if err != nil {
-		return 0, err
-	}
-	return reply.ToInt(2)
-}
-
-// SetCWMacrosSpeed sets the speed in WPM for CW macros.
-func (c *Client) SetCWMacrosSpeed(wpm int) error {
-	_, err := c.command("cw_macros_speed", wpm)
-	return err
-}
-
-// CWMacrosSpeed reads the speed in WPM for CW macros.
-func (c *Client) CWMacrosSpeed() (int, error) {
-	reply, err := c.request("cw_macros_speed")
-	if err != nil {
-		return 0, err
-	}
-	return reply.ToInt(0)
-}
-
-// CWMacrosSpeedInc increases the speed for CW macros by the given delta in WPM.
-func (c *Client) CWMacrosSpeedInc(delta int) error {
-	_, err := c.command("cw_macros_speed_up", delta)
-	return err
-}
-
-// CWMacrosSpeedDec decreases the speed for CW macros by the given delta in WPM.
-func (c *Client) CWMacrosSpeedDec(delta int) error {
-	_, err := c.request("cw_macros_speed_down", delta)
-	return err
-}
-
-// SetCWMacrosDelay sets the delay between keying the TRX and transmitting a CW macros in milliseconds.
-func (c *Client) SetCWMacrosDelay(delay int) error {
-	_, err := c.command("cw_macros_delay", delay)
-	return err
-}
-
-// CWMacrosDelay reads the delay for transmitting CW macros in milliseconds.
-func (c *Client) CWMacrosDelay() (int, error) {
-	reply, err := c.request("cw_macros_delay")
-	if err != nil {
-		return 0, err
-	}
-	return reply.ToInt(0)
-}
-
-// SetTX enables the TX of the given TRX using the given signal source. Use "" (SignalSourceDefault) if you want to use the default source for the current mode.
-func (c *Client) SetTX(trx int, enabled bool, source SignalSource) error {
-	var err error
-	if source == SignalSourceDefault {
-		_, err = c.command("trx", trx, enabled)
-	} else {
-		_, err = c.command("trx", trx, enabled, source)
-	}
-	return err
-}
-
-// TX reads the current state of the given TRX's transmitter.
-func (c *Client) TX(trx int) (bool, error) {
-	reply, err := c.request("trx", trx)
-	if err != nil {
-		return false, err
-	}
-	return reply.ToBool(1)
-}
-
-// SetTune enables the given TRX's transmitter in tuning.
-func (c *Client) SetTune(trx int, enabled bool) error {
-	_, err := c.command("tune", trx, enabled)
-	return err
-}
-
-// Tune reads the current state of the given TRX's tuning transmitter.
-func (c *Client) Tune(trx int) (bool, error) {
-	reply, err := c.request("tune", trx)
-	if err != nil {
-		return false, err
-	}
-	return reply.ToBool(1)
-}
-
-// SetDrive sets the output power in percent.
-// Starting from TCI version 1.5, this command affects TRX 0.
-func (c *Client) SetDrive(percent int) error {
-	var err error
-	if c.tciVersion.Beyond(tci_1_4) {
-		_, err = c.command("drive", 0, percent)
-	} else {
-		_, err = c.command("drive", percent)
-	}
-	return err
+// TRXTuneDrive reads the output power for tuning of a certain TRX in percent. (since TCI 1.5)
+func (c *Client) TRXTuneDrive(trx int) (int, error) {
+	return c.TRXTuneDriveContext(context.Background(), trx)
 }
 
-// Drive reads the output power in percent.
-// Starting from TCI version 1.5, this command affects TRX 0.
-func (c *Client) Drive() (int, error) {
-	var reply Message
-	var err error
-	if c.tciVersion.Beyond(tci_1_4) {
-		reply, err = c.request("drive", 0)
-
-	} else {
-		reply, err = c.request("drive")
-	}
-	if err != nil {
+// TRXTuneDriveContext is like TRXTuneDrive, but uses the given context.
+func (c *Client) TRXTuneDriveContext(ctx context.Context, trx int) (int, error) {
+	if err := c.checkVersion("TRXTuneDrive", tci_1_5); err != nil {
 		return 0, err
 	}
-	return reply.ToInt(0)
-}
-
-// SetTRXDrive sets the output power for a certain TRX in percent. (since TCI 1.5)
-func (c *Client) SetTRXDrive(trx int, percent int) error {
-	if !c.tciVersion.AtLeast(tci_1_5) {
-		return fmt.Errorf("SetTRXDrive requires at least TCI 1.5")
-	}
-	_, err := c.command("drive", trx, percent)
-	return err
-}
-
-// TRXDrive reads the output power of a certain TRX in percent. (since TCI 1.5)
-func (c *Client) TRXDrive(trx int) (int, error) {
-	if !c.tciVersion.AtLeast(tci_1_5) {
-		return 0, fmt.Errorf("TRXDrive requires at least TCI 1.5")
-	}
-	reply, err := c.request("drive", trx)
+	reply, err := c.request(ctx, "tune_drive", trx)
 	if err != nil {
 		return 0, err
 	}
 	return reply.ToInt(1)
 }
 
-// SetTuneDrive sets the output power for tuning in percent.
-func (c *Client) SetTuneDrive(percent int) error {
-	_, err := c.command("tune_drive", percent)
-	return err
+// StartIQ starts the transmission of IQ data for the given TRX.
+func (c *Client) StartIQ(trx int) error {
+	return c.StartIQContext(context.Background(), trx)
 }
 
-// TuneDrive reads the output power for tuning in percent.
-func (c *Client) TuneDrive() (int, error) {
-	reply, err := c.request("tune_drive")
+// StartIQContext is like StartIQ, but uses the given context.
+func (c *Client) StartIQContext(ctx context.Context, trx int) error {
+	_, err := c.command(ctx, "iq_start", trx)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	return reply.ToInt(0)
+	c.resync.remember(resyncStream, trxKey("iq", trx), NewCommandMessage("iq_start", trx))
+	return nil
 }
 
-// SetTRXTuneDrive sets the output power for tuning for a certain TRX in percent. (since TCI 1.5)
-func (c *Client) SetTRXTuneDrive(trx int, percent int) error {
-	if !c.tciVersion.AtLeast(tci_1_5) {
-		return fmt.Errorf("SetTRXTuneDrive requires at least TCI 1.5")
-	}
-	_, err := c.command("tune_drive", trx, percent)
-	return err
+// StopIQ stops the transmission of IQ data for the given TRX.
+func (c *Client) StopIQ(trx int) error {
+	return c.StopIQContext(context.Background(), trx)
 }
 
-// TRXTuneDrive reads the output power for tuning of a certain TRX in percent. (since TCI 1.5)
-func (c *Client) TRXTuneDrive(trx int) (int, error) {
-	if !c.tciVersion.AtLeast(tci_1_5) {
-		return 0, fmt.Errorf("TRXTuneDrive requires at least TCI 1.5")
-	}
-	reply, err := c.request("tune_drive", trx)
+// StopIQContext is like StopIQ, but uses the given context.
+func (c *Client) StopIQContext(ctx context.Context, trx int) error {
+	_, err := c.command(ctx, "iq_stop", trx)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	return reply.ToInt(1)
-}
-
-// StartIQ starts the transmission of IQ data for the given TRX.
-func (c *Client) StartIQ(trx int) error {
-	_, err := c.command("iq_start", trx)
-	return err
-}
-
-// StopIQ stops the transmission of IQ data for the given TRX.
-func (c *Client) StopIQ(trx int) error {
-	_, err := c.command("iq_stop", trx)
-	return err
+	c.resync.forget(trxKey("iq", trx))
+	return nil
 }
 
 // SetIQSampleRate sets sample rate for IQ data.
 func (c *Client) SetIQSampleRate(sampleRate IQSampleRate) error {
-	_, err := c.command("iq_samplerate", sampleRate)
-	return err
+	return c.SetIQSampleRateContext(context.Background(), sampleRate)
 }
 
-// IQSampleRate reads the sample rate for IQ data.
-func (c *Client) IQSampleRate() (IQSampleRate, error) {
-	reply, err := c.request("iq_samplerate")
+// SetIQSampleRateContext is like SetIQSampleRate, but uses the given context.
+func (c *Client) SetIQSampleRateContext(ctx context.Context, sampleRate IQSampleRate) error {
+	_, err := c.command(ctx, "iq_samplerate", sampleRate)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	sampleRate, err := reply.ToInt(0)
-	return IQSampleRate(sampleRate), err
+	c.resync.remember(resyncSetting, "iq_samplerate", NewCommandMessage("iq_samplerate", sampleRate))
+	return nil
 }
 
 // StartAudio starts the transmission of audio data for the given TRX.
 func (c *Client) StartAudio(trx int) error {
-	_, err := c.command("audio_start", trx)
-	return err
+	return c.StartAudioContext(context.Background(), trx)
 }
 
-// StopAudio stops the transmission of audio data for the given TRX.
-func (c *Client) StopAudio(trx int) error {
-	_, err := c.command("audio_stop", trx)
-	return err
-}
-
-// SetAudioSampleRate sets sample rate for Audio data.
-func (c *Client) SetAudioSampleRate(sampleRate AudioSampleRate) error {
-	_, err := c.command("audio_samplerate", sampleRate)
-	return err
-}
-
-// AudioSampleRate reads the sample rate for Audio data.
-func (c *Client) AudioSampleRate() (AudioSampleRate, error) {
-	reply, err := c.request("audio_samplerate")
+// StartAudioContext is like StartAudio, but uses the given context.
+func (c *Client) StartAudioContext(ctx context.Context, trx int) error {
+	_, err := c.command(ctx, "audio_start", trx)
 	if err != nil {
-		return 0, err
-	}
-	sampleRate, err := reply.ToInt(0)
-	return AudioSampleRate(sampleRate), err
-}
-
-// AddSpot adds a spot to the panorama display.
-func (c *Client) AddSpot(callsign string, mode Mode, frequency int, color ARGB, text string) error {
-	_, err := c.command("spot", callsign, mode, frequency, color, text)
-	return err
-}
-
-// DeleteSpot deletes the spot with the given callsign.
-func (c *Client) DeleteSpot(callsign string) error {
-	_, err := c.command("spot_delete", callsign)
-	return err
-}
-
-// ClearSpots deletes all spots.
-func (c *Client) ClearSpots() error {
-	_, err := c.command("spot_clear")
-	return err
-}
-
-// SetVolume sets the main volume in dB (range from -60dB to 0dB).
-func (c *Client) SetVolume(dB int) error {
-	_, err := c.command("volume", dB)
-	return err
-}
-
-// Volume reads the main volume in dB (range from -60dB to 0dB).
-func (c *Client) Volume() (int, error) {
-	reply, err := c.request("volume")
-	if err != nil {
-		return 0, err
-	}
-	return reply.ToInt(0)
-}
-
-// SetSquelchEnable enables the given TRX's squelch.
-func (c *Client) SetSquelchEnable(trx int, enabled bool) error {
-	_, err := c.command("sql_enable", trx, enabled)
-	return err
-}
-
-// SquelchEnable reads the enable state of the given TRX's squelch.
-func (c *Client) SquelchEnable(trx int) (bool, error) {
-	reply, err := c.request("sql_enable", trx)
-	if err != nil {
-		return false, err
-	}
-	return reply.ToBool(1)
-}
-
-// SetSquelchLevel sets given TRX's squelch threshold in dB (range from -140dB to 0dB).
-func (c *Client) SetSquelchLevel(dB int) error {
-	_, err := c.command("sql_level", dB)
-	return err
-}
-
-// SquelchLevel reads the given TRX's squelch threshold in dB (range from -140dB to 0dB).
-func (c *Client) SquelchLevel() (int, error) {
-	reply, err := c.request("sql_level")
-	if err != nil {
-		return 0, err
-	}
-	return reply.ToInt(0)
-}
-
-// SetVFOFrequency sets the tuning frequency of the given TRX's vfo.
-func (c *Client) SetVFOFrequency(trx int, vfo VFO, frequency int) error {
-	_, err := c.command("vfo", trx, vfo, frequency)
-	return err
-}
-
-// VFOFrequency reads the tuning frequency of the given TRX's vfo.
-func (c *Client) VFOFrequency(trx int, vfo VFO) (int, error) {
-	reply, err := c.request("vfo", trx, vfo)
-	if err != nil {
-		return 0, err
-	}
-	return reply.ToInt(2)
-}
-
-// BringToFront brings main ExpertSDR window into the focus.
-func (c *Client) BringToFront() error {
-	_, err := c.command("set_in_focus")
-	return err
-}
-
-// SetMute mutes the main volume.
-func (c *Client) SetMute(muted bool) error {
-	_, err := c.command("mute", muted)
-	return err
-}
-
-// Mute reads main volume's mute state.
-func (c *Client) Mute() (bool, error) {
-	reply, err := c.request("mute")
-	if err != nil {
-		return false, err
-	}
-	return reply.ToBool(0)
-}
-
-// SetRXMute mutes the given TRX's receiver.
-func (c *Client) SetRXMute(trx int, muted bool) error {
-	_, err := c.command("rx_mute", trx, muted)
-	return err
-}
-
-// RXMute reads given TRX's receiver mute state.
-func (c *Client) RXMute(trx int) (bool, error) {
-	reply, err := c.request("rx_mute", trx)
-	if err != nil {
-		return false, err
+		return err
 	}
-	return reply.ToBool(1)
+	c.resync.remember(resyncStream, trxKey("audio", trx), NewCommandMessage("audio_start", trx))
+	return nil
 }
 
-// SetCTCSSEnable enables CTCSS for the given TRX.
-func (c *Client) SetCTCSSEnable(trx int, muted bool) error {
-	_, err := c.command("ctcss_enable", trx, muted)
-	return err
+// StopAudio stops the transmission of audio data for the given TRX.
+func (c *Client) StopAudio(trx int) error {
+	return c.StopAudioContext(context.Background(), trx)
 }
 
-// CTCSSEnable reads enable state of CTCSS for the given TRX.
-func (c *Client) CTCSSEnable(trx int) (bool, error) {
-	reply, err := c.request("ctcss_enable", trx)
+// StopAudioContext is like StopAudio, but uses the given context.
+func (c *Client) StopAudioContext(ctx context.Context, trx int) error {
+	_, err := c.command(ctx, "audio_stop", trx)
 	if err != nil {
-		return false, err
+		return err
 	}
-	return reply.ToBool(1)
+	c.resync.forget(trxKey("audio", trx))
+	return nil
 }
 
-// SetCTCSSMode sets the CTCSS mode of the given TRX.
-func (c *Client) SetCTCSSMode(trx int, mode CTCSSMode) error {
-	_, err := c.command("ctcss_mode", trx, mode)
-	return err
+// SetAudioSampleRate sets sample rate for Audio data.
+func (c *Client) SetAudioSampleRate(sampleRate AudioSampleRate) error {
+	return c.SetAudioSampleRateContext(context.Background(), sampleRate)
 }
 
-// CTCSSMode reads the CTCSS mode of the given TRX.
-func (c *Client) CTCSSMode(trx int) (CTCSSMode, error) {
-	reply, err := c.request("ctcss_enable", trx)
+// SetAudioSampleRateContext is like SetAudioSampleRate, but uses the given context.
+func (c *Client) SetAudioSampleRateContext(ctx context.Context, sampleRate AudioSampleRate) error {
+	_, err := c.command(ctx, "audio_samplerate", sampleRate)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	mode, err := reply.ToInt(1)
-	return CTCSSMode(mode), err
+	c.resync.remember(resyncSetting, "audio_samplerate", NewCommandMessage("audio_samplerate", sampleRate))
+	return nil
 }
 
-// SetCTCSSRXTone sets the given TRX's CTCSS subtone for receiving.
-func (c *Client) SetCTCSSRXTone(trx int, tone CTCSSTone) error {
-	_, err := c.command("ctcss_rx_tone", trx, tone)
-	return err
+// SetAudioStreamSampleType sets the sample type for the RX audio stream. (since TCI 1.6)
+func (c *Client) SetAudioStreamSampleType(sampleType SampleType) error {
+	return c.SetAudioStreamSampleTypeContext(context.Background(), sampleType)
 }
 
-// CTCSSRXTone reads the given TRX's CTCSS subtone for receiving.
-func (c *Client) CTCSSRXTone(trx int) (CTCSSTone, error) {
-	reply, err := c.request("ctcss_rx_tone", trx)
+// SetAudioStreamSampleTypeContext is like SetAudioStreamSampleType, but uses the given context.
+func (c *Client) SetAudioStreamSampleTypeContext(ctx context.Context, sampleType SampleType) error {
+	_, err := c.command(ctx, "audio_stream_sample_type", sampleType)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	tone, err := reply.ToInt(1)
-	return CTCSSTone(tone), err
+	c.resync.remember(resyncSetting, "audio_stream_sample_type", NewCommandMessage("audio_stream_sample_type", sampleType))
+	return nil
 }
 
-// SetCTCSSTXTone sets the given TRX's CTCSS subtone for transmitting.
-func (c *Client) SetCTCSSTXTone(trx int, tone CTCSSTone) error {
-	_, err := c.command("ctcss_tx_tone", trx, tone)
-	return err
+// SetAudioStreamChannels sets the number of channels for the RX audio stream (1 = mono, 2 = stereo). (since TCI 1.6)
+func (c *Client) SetAudioStreamChannels(count int) error {
+	return c.SetAudioStreamChannelsContext(context.Background(), count)
 }
 
-// CTCSSTXTone reads the given TRX's CTCSS subtone for transmitting.
-func (c *Client) CTCSSTXTone(trx int) (CTCSSTone, error) {
-	reply, err := c.request("ctcss_tx_tone", trx)
+// SetAudioStreamChannelsContext is like SetAudioStreamChannels, but uses the given context.
+func (c *Client) SetAudioStreamChannelsContext(ctx context.Context, count int) error {
+	_, err := c.command(ctx, "audio_stream_channels", count)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	tone, err := reply.ToInt(1)
-	return CTCSSTone(tone), err
+	c.resync.remember(resyncSetting, "audio_stream_channels", NewCommandMessage("audio_stream_channels", count))
+	return nil
 }
 
-// SetCTCSSLevel sets the given TRX's CTCSS subtone level for transmitting in percent.
-func (c *Client) SetCTCSSLevel(trx int, percent int) error {
-	_, err := c.command("ctcss_level", trx, percent)
-	return err
+// AddSpot adds a spot to the panorama display.
+func (c *Client) AddSpot(callsign string, mode Mode, frequency int, color ARGB, text string) error {
+	return c.AddSpotContext(context.Background(), callsign, mode, frequency, color, text)
 }
 
-// CTCSSLevel reads the given TRX's CTCSS subtone level for transmitting in percent.
-func (c *Client) CTCSSLevel(trx int) (int, error) {
-	reply, err := c.request("ctcss_level", trx)
-	if err != nil {
-		return 0, err
+// AddSpotContext is like AddSpot, but uses the given context.
+func (c *Client) AddSpotContext(ctx context.Context, callsign string, mode Mode, frequency int, color ARGB, text string) error {
+	if callsign == "" {
+		return &InvalidArgumentError{Command: "spot", Index: 0, Value: callsign, Reason: "the callsign must not be empty"}
 	}
-	return reply.ToInt(1)
-}
-
-// SetECoderSwitchRX assigns the given TRX's control to the given E-Coder.
-func (c *Client) SetECoderSwitchRX(ecoder int, trx int) error {
-	_, err := c.command("ecoder_switch_rx", ecoder, trx)
-	return err
-}
-
-// ECoderSwitchRX reads which TRX is assigned to the given E-Coder.
-func (c *Client) ECoderSwitchRX(ecoder int) (int, error) {
-	reply, err := c.request("ecoder_switch_rx", ecoder)
-	if err != nil {
-		return 0, err
+	msg := NewCommandMessage("spot", callsign, mode, frequency, color, text)
+	if err := msg.Err(); err != nil {
+		return err
 	}
-	return reply.ToInt(1)
-}
-
-// SetECoderSwitchChannel assigns the given channel's control to the given E-Coder.
-func (c *Client) SetECoderSwitchChannel(ecoder int, vfo VFO) error {
-	_, err := c.command("ecoder_switch_channel", ecoder, vfo)
-	return err
-}
-
-// ECoderSwitchChannel reads which channel is assigned to the given E-Coder.
-func (c *Client) ECoderSwitchChannel(ecoder int) (VFO, error) {
-	reply, err := c.request("ecoder_switch_channel", ecoder)
+	_, err := c.command(ctx, "spot", callsign, mode, frequency, color, text)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	vfo, err := reply.ToInt(1)
-	return VFO(vfo), err
+	c.resync.remember(resyncSpot, "spot:"+callsign, msg)
+	return nil
 }
 
-// SetRXVolume sets the given TRX's channel volume in dB (range from -60dB to 0dB).
-func (c *Client) SetRXVolume(trx int, vfo VFO, dB int) error {
-	_, err := c.command("rx_volume", trx, vfo, dB)
-	return err
+// DeleteSpot deletes the spot with the given callsign.
+func (c *Client) DeleteSpot(callsign string) error {
+	return c.DeleteSpotContext(context.Background(), callsign)
 }
 
-// RXVolume reads the given TRX's channel volume in dB (range from -60dB to 0dB).
-func (c *Client) RXVolume(trx int, vfo VFO) (int, error) {
-	reply, err := c.request("rx_volume", trx, vfo)
+// DeleteSpotContext is like DeleteSpot, but uses the given context.
+func (c *Client) DeleteSpotContext(ctx context.Context, callsign string) error {
+	_, err := c.command(ctx, "spot_delete", callsign)
 	if err != nil {
-		return 0, err
+		return err
 	}
-	return reply.ToInt(2)
+	c.resync.forget("spot:" + callsign)
+	return nil
 }
 
-// SetRXBalance sets the given TRX's channel balance in dB (range from -40dB to 40dB).
-func (c *Client) SetRXBalance(trx int, vfo VFO, dB int) error {
-	_, err := c.command("rx_balance", trx, vfo, dB)
-	return err
+// ClearSpots deletes all spots.
+func (c *Client) ClearSpots() error {
+	return c.ClearSpotsContext(context.Background())
 }
 
-// RXBalance reads the given TRX's channel balance in dB (range from -40dB to 40dB).
-func (c *Client) RXBalance(trx int, vfo VFO) (int, error) {
-	reply, err := c.request("rx_balance", trx, vfo)
+// ClearSpotsContext is like ClearSpots, but uses the given context.
+func (c *Client) ClearSpotsContext(ctx context.Context) error {
+	_, err := c.command(ctx, "spot_clear")
 	if err != nil {
-		return 0, err
+		return err
 	}
-	return reply.ToInt(2)
+	c.resync.forgetGroup(resyncSpot)
+	return nil
 }
 
 // SetRXSensorsEnable enables/disables the sharing of receiver sensor readings with the given interval in milliseconds. (since TCI 1.5)
 func (c *Client) SetRXSensorsEnable(enabled bool, milliseconds int) error {
+	return c.SetRXSensorsEnableContext(context.Background(), enabled, milliseconds)
+}
+
+// SetRXSensorsEnableContext is like SetRXSensorsEnable, but uses the given context.
+func (c *Client) SetRXSensorsEnableContext(ctx context.Context, enabled bool, milliseconds int) error {
 	var err error
 	if enabled {
-		_, err = c.command("rx_sensors_enable", true, milliseconds)
+		_, err = c.command(ctx, "rx_sensors_enable", true, milliseconds)
+		if err == nil {
+			c.resync.remember(resyncSetting, "rx_sensors_enable", NewCommandMessage("rx_sensors_enable", true, milliseconds))
+		}
 	} else {
-		_, err = c.command("rx_sensors_enable", false)
+		_, err = c.command(ctx, "rx_sensors_enable", false)
+		if err == nil {
+			c.resync.forget("rx_sensors_enable")
+		}
 	}
 	return err
 }
 
 // SetTXSensorsEnable enables/disables the sharing of transmitter sensor readings with the given interval in milliseconds. (since TCI 1.5)
 func (c *Client) SetTXSensorsEnable(enabled bool, milliseconds int) error {
+	return c.SetTXSensorsEnableContext(context.Background(), enabled, milliseconds)
+}
+
+// SetTXSensorsEnableContext is like SetTXSensorsEnable, but uses the given context.
+func (c *Client) SetTXSensorsEnableContext(ctx context.Context, enabled bool, milliseconds int) error {
 	var err error
 	if enabled {
-		_, err = c.command("tx_sensors_enable", true, milliseconds)
+		_, err = c.command(ctx, "tx_sensors_enable", true, milliseconds)
+		if err == nil {
+			c.resync.remember(resyncSetting, "tx_sensors_enable", NewCommandMessage("tx_sensors_enable", true, milliseconds))
+		}
 	} else {
-		_, err = c.command("tx_sensors_enable", false)
-	}
-	return err
-}
-
-// SetRXNBEnable enables/disables the given TRX's noise blanker. (since TCI 1.6)
-func (c *Client) SetRXNBEnable(trx int, enabled bool) error {
-	_, err := c.command("rx_nb_enable", trx, enabled)
-	return err
-}
-
-// RXNBEnable reads the given TRX's noise blanker enable state. (since TCI 1.6)
-func (c *Client) RXNBEnable(trx int) (bool, error) {
-	reply, err := c.request("rx_nb_enable", trx)
-	if err != nil {
-		return false, err
-	}
-	return reply.ToBool(2)
-}
-
-// SetRXNBParams sets the given TRX's noise blanker parameters. (since TCI 1.6)
-func (c *Client) SetRXNBParams(trx int, threshold int, impulseLength int) error {
-	_, err := c.command("rx_nb_param", trx, threshold, impulseLength)
-	return err
-}
-
-// RXNBParams reads the given TRX's noise blanker parameters. (since TCI 1.6)
-func (c *Client) RXNBParams(trx int) (int, int, error) {
-	reply, err := c.request("rx_nb_param", trx)
-	if err != nil {
-		return 0, 0, err
-	}
-	threshold, err := reply.ToInt(1)
-	if err != nil {
-		return 0, 0, err
-	}
-	impulseLength, err := reply.ToInt(2)
-	if err != nil {
-		return 0, 0, err
-	}
-	return threshold, impulseLength, nil
-}
-
-// SetRXBinEnable enables/disables the given TRX's pseudo stereo for CW. (since TCI 1.6)
-func (c *Client) SetRXBinEnable(trx int, enabled bool) error {
-	_, err := c.command("rx_bin_enable", trx, enabled)
-	return err
-}
-
-// RXBinEnable reads the given TRX's pseudo stereo enable state. (since TCI 1.6)
-func (c *Client) RXBinEnable(trx int) (bool, error) {
-	reply, err := c.request("rx_bin_enable", trx)
-	if err != nil {
-		return false, err
-	}
-	return reply.ToBool(2)
-}
-
-// SetRXNREnable enables/disables the given TRX's noise reduction. (since TCI 1.6)
-func (c *Client) SetRXNREnable(trx int, enabled bool) error {
-	_, err := c.command("rx_nr_enable", trx, enabled)
-	return err
-}
-
-// RXNREnable reads the given TRX's noise reduction enable state. (since TCI 1.6)
-func (c *Client) RXNREnable(trx int) (bool, error) {
-	reply, err := c.request("rx_nr_enable", trx)
-	if err != nil {
-		return false, err
-	}
-	return reply.ToBool(2)
-}
-
-// SetRXANCEnable enables/disables the given TRX's automatic noise cancellation. (since TCI 1.6)
-func (c *Client) SetRXANCEnable(trx int, enabled bool) error {
-	_, err := c.command("rx_anc_enable", trx, enabled)
-	return err
-}
-
-// RXANCEnable reads the given TRX's automatic noise cancellation enable state. (since TCI 1.6)
-func (c *Client) RXANCEnable(trx int) (bool, error) {
-	reply, err := c.request("rx_anc_enable", trx)
-	if err != nil {
-		return false, err
-	}
-	return reply.ToBool(2)
-}
-
-// SetRXANFEnable enables/disables the given TRX's automatic notch filter. (since TCI 1.6)
-func (c *Client) SetRXANFEnable(trx int, enabled bool) error {
-	_, err := c.command("rx_anf_enable", trx, enabled)
-	return err
-}
-
-// RXANFEnable reads the given TRX's automatic notch filter enable state. (since TCI 1.6)
-func (c *Client) RXANFEnable(trx int) (bool, error) {
-	reply, err := c.request("rx_anf_enable", trx)
-	if err != nil {
-		return false, err
-	}
-	return reply.ToBool(2)
-}
-
-// SetRXAPFEnable enables/disables the given TRX's analogue peak filter. (since TCI 1.6)
-func (c *Client) SetRXAPFEnable(trx int, enabled bool) error {
-	_, err := c.command("rx_apf_enable", trx, enabled)
-	return err
-}
-
-// RXANFEnable reads the given TRX's analogue peak filter enable state. (since TCI 1.6)
-func (c *Client) RXAPFEnable(trx int) (bool, error) {
-	reply, err := c.request("rx_apf_enable", trx)
-	if err != nil {
-		return false, err
-	}
-	return reply.ToBool(2)
-}
-
-// SetRXDSEEnable enables/disables the given TRX's digital surround sound effect. (since TCI 1.6)
-func (c *Client) SetRXDSEEnable(trx int, enabled bool) error {
-	_, err := c.command("rx_dse_enable", trx, enabled)
-	return err
-}
-
-// RXDSEEnable reads the given TRX's digital surround sound effect enable state. (since TCI 1.6)
-func (c *Client) RXDSEEnable(trx int) (bool, error) {
-	reply, err := c.request("rx_dse_enable", trx)
-	if err != nil {
-		return false, err
+		_, err = c.command(ctx, "tx_sensors_enable", false)
+		if err == nil {
+			c.resync.forget("tx_sensors_enable")
+		}
 	}
-	return reply.ToBool(2)
-}
-
-// SetRXNFEnable enables/disables the given TRX's band notch filters. (since TCI 1.6)
-func (c *Client) SetRXNFEnable(trx int, enabled bool) error {
-	_, err := c.command("rx_nf_enable", trx, enabled)
 	return err
 }
-
-// RXNFEnable reads the given TRX's band notch filters enable state. (since TCI 1.6)
-func (c *Client) RXNFEnable(trx int) (bool, error) {
-	reply, err := c.request("rx_nf_enable", trx)
-	if err != nil {
-		return false, err
-	}
-	return reply.ToBool(2)
-}
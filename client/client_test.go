@@ -0,0 +1,341 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ftl/tci/client"
+	"github.com/ftl/tci/client/clienttest"
+)
+
+func openTestClient(t *testing.T, listeners ...interface{}) (*clienttest.Server, *client.Client) {
+	t.Helper()
+	server := clienttest.NewServer()
+	t.Cleanup(server.Close)
+	c, err := client.Open(server.Addr(), false, listeners...)
+	require.NoError(t, err)
+	t.Cleanup(c.Disconnect)
+	return server, c
+}
+
+func TestOpen_CollectsDeviceInfo(t *testing.T) {
+	_, c := openTestClient(t)
+
+	assert.True(t, c.Connected())
+	info := c.DeviceInfo()
+	assert.Equal(t, "SunSDR2PRO", info.DeviceName)
+	assert.Equal(t, "1.8", info.ProtocolVersion)
+	assert.Equal(t, client.Version(1.8), c.ProtocolVersion())
+	assert.Equal(t, 2, info.TRXCount)
+	assert.Equal(t, 10000, info.MinVFOFrequency)
+	assert.Equal(t, 30000000, info.MaxVFOFrequency)
+	assert.Contains(t, info.Modes, client.ModeDIGU)
+}
+
+func TestRequestAndCommand(t *testing.T) {
+	server, c := openTestClient(t)
+
+	frequency, err := c.VFOFrequency(0, client.VFOB)
+	require.NoError(t, err)
+	assert.Equal(t, 7012500, frequency)
+
+	err = c.SetVFOFrequency(0, client.VFOB, 7020000)
+	require.NoError(t, err)
+	state, ok := server.State("vfo", 0, 1)
+	require.True(t, ok)
+	assert.Equal(t, []string{"0", "1", "7020000"}, state.Args())
+
+	frequency, err = c.VFOFrequency(0, client.VFOB)
+	require.NoError(t, err)
+	assert.Equal(t, 7020000, frequency)
+}
+
+func TestRequestTimeout(t *testing.T) {
+	server, c := openTestClient(t)
+	server.Silence("dds")
+
+	_, err := c.DDS(0)
+	assert.ErrorIs(t, err, client.ErrTimeout)
+}
+
+func TestRequestContextCanceled(t *testing.T) {
+	server, c := openTestClient(t)
+	server.Silence("dds")
+	c.SetTimeout(time.Second)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err := c.DDSContext(ctx, 0)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestCommandContextCanceled_FreesPendingSlot(t *testing.T) {
+	server, c := openTestClient(t)
+	server.Silence("rit_offset")
+	c.SetTimeout(time.Second)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- c.SetRITOffsetContext(ctx, 0, 10)
+	}()
+	require.Eventually(t, func() bool {
+		return len(server.Received()) == 1
+	}, 500*time.Millisecond, time.Millisecond)
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+
+	// The next command must not wait for the deadline of the canceled command.
+	start := time.Now()
+	require.NoError(t, c.SetVFOFrequency(0, client.VFOA, 7020000))
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
+
+func TestPipelinedCommands(t *testing.T) {
+	server, c := openTestClient(t)
+	c.SetPipelineDepth(8)
+
+	done := make(chan error)
+	for i := 0; i < 8; i++ {
+		go func(i int) {
+			done <- c.SetRITOffset(0, i*10)
+		}(i)
+	}
+	for i := 0; i < 8; i++ {
+		assert.NoError(t, <-done)
+	}
+	assert.Len(t, server.Received(), 8)
+}
+
+func TestPipelinedCommands_AreInFlightConcurrently(t *testing.T) {
+	server, c := openTestClient(t)
+	server.Silence("rit_offset")
+	c.SetTimeout(time.Second)
+	c.SetPipelineDepth(4)
+
+	done := make(chan error, 4)
+	for i := 0; i < 4; i++ {
+		go func(i int) {
+			done <- c.SetRITOffset(0, i*10)
+		}(i)
+	}
+
+	// The server neither echoes nor answers, so all commands are still waiting for their echo.
+	require.Eventually(t, func() bool {
+		return len(server.Received()) == 4
+	}, 500*time.Millisecond, time.Millisecond)
+	assert.Empty(t, done)
+
+	for _, msg := range server.Received() {
+		require.NoError(t, server.Send(msg))
+	}
+	for i := 0; i < 4; i++ {
+		assert.NoError(t, <-done)
+	}
+}
+
+func TestPipelinedCommands_PrefixCollidingArguments(t *testing.T) {
+	server, c := openTestClient(t)
+	server.Silence("rit_offset")
+	c.SetTimeout(100 * time.Millisecond)
+	c.SetPipelineDepth(2)
+
+	done1 := make(chan error, 1)
+	go func() {
+		done1 <- c.SetRITOffset(0, 1)
+	}()
+	require.Eventually(t, func() bool {
+		return len(server.Received()) == 1
+	}, 500*time.Millisecond, time.Millisecond)
+	done10 := make(chan error, 1)
+	go func() {
+		done10 <- c.SetRITOffset(0, 10)
+	}()
+	require.Eventually(t, func() bool {
+		return len(server.Received()) == 2
+	}, 500*time.Millisecond, time.Millisecond)
+
+	// The echo of rit_offset:0,10 must not complete the pending rit_offset:0,1.
+	received := server.Received()
+	require.NoError(t, server.Send(received[1]))
+	require.NoError(t, server.Send(received[0]))
+
+	assert.NoError(t, <-done1)
+	assert.NoError(t, <-done10)
+}
+
+func TestSlowListener_DoesNotDelayReplies(t *testing.T) {
+	_, c := openTestClient(t)
+	release := make(chan struct{})
+	defer close(release)
+	c.OnMessage(func(client.Message) {
+		<-release
+	})
+
+	for i := 0; i < 100; i++ {
+		require.NoError(t, c.SetVFOFrequency(0, client.VFOA, 7000000+i))
+	}
+}
+
+func TestKeepOpen_Reconnects(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	connected := make(chan bool, 2)
+
+	c := client.KeepOpen(server.Addr(), 10*time.Millisecond, false, client.ConnectionListenerFunc(func(value bool) {
+		connected <- value
+	}))
+	defer c.Disconnect()
+
+	assert.True(t, <-connected)
+	server.DisconnectClients()
+	assert.False(t, <-connected)
+	assert.True(t, <-connected)
+}
+
+type streamListener struct {
+	iq     chan []float32
+	audio  chan []float32
+	chrono chan uint32
+}
+
+func (l *streamListener) IQData(_ int, _ client.IQSampleRate, data []float32) {
+	l.iq <- data
+}
+
+func (l *streamListener) RXAudio(_ int, _ client.AudioSampleRate, samples []float32) {
+	l.audio <- samples
+}
+
+func (l *streamListener) TXChrono(_ int, _ client.AudioSampleRate, requestedSampleCount uint32) {
+	l.chrono <- requestedSampleCount
+}
+
+func TestBinaryMessages(t *testing.T) {
+	listener := &streamListener{
+		iq:     make(chan []float32, 1),
+		audio:  make(chan []float32, 1),
+		chrono: make(chan uint32, 1),
+	}
+	server, _ := openTestClient(t, listener)
+
+	require.NoError(t, server.SendIQData(0, client.IQSampleRate48k, []float32{0.5, -0.5}))
+	assert.Equal(t, []float32{0.5, -0.5}, <-listener.iq)
+
+	require.NoError(t, server.SendRXAudio(0, client.AudioSampleRate48k, []float32{0.25, 0.25}))
+	assert.Equal(t, []float32{0.25, 0.25}, <-listener.audio)
+
+	require.NoError(t, server.SendTXChrono(0, client.AudioSampleRate48k, 2048))
+	assert.Equal(t, uint32(2048), <-listener.chrono)
+}
+
+func TestAudioStreamSampleType(t *testing.T) {
+	listener := &streamListener{audio: make(chan []float32, 1)}
+	server, c := openTestClient(t, listener)
+
+	require.NoError(t, c.SetAudioStreamSampleType(client.SampleTypeInt16))
+	sampleType, err := c.AudioStreamSampleType()
+	require.NoError(t, err)
+	assert.Equal(t, client.SampleTypeInt16, sampleType)
+
+	require.NoError(t, server.SendRXAudio(0, client.AudioSampleRate48k, []float32{0.5, -0.5}))
+	assert.Equal(t, []float32{0.5, -0.5}, <-listener.audio)
+}
+
+func TestOpenURL(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+
+	c, err := client.OpenURL(server.URL())
+	require.NoError(t, err)
+	defer c.Disconnect()
+	assert.True(t, c.Connected())
+
+	c2, err := client.OpenURL(fmt.Sprintf("localhost:%d", server.Addr().Port))
+	require.NoError(t, err)
+	defer c2.Disconnect()
+	assert.True(t, c2.Connected())
+
+	c3, err := client.OpenURL(fmt.Sprintf(":%d", server.Addr().Port))
+	require.NoError(t, err)
+	defer c3.Disconnect()
+	assert.True(t, c3.Connected())
+}
+
+func TestOpenURL_InvalidAddress(t *testing.T) {
+	addresses := []string{
+		"http://localhost:40001",
+		"ws://",
+		"ws://:40001",
+		"ws://localhost:tci",
+		"ws://localhost:65536",
+		"localhost:0",
+		"localhost:70000",
+		"localhost:-1",
+		"localhost:tci",
+	}
+	for _, address := range addresses {
+		_, err := client.OpenURL(address)
+		assert.Error(t, err, address)
+	}
+}
+
+func TestAddSpot_EscapesText(t *testing.T) {
+	server, c := openTestClient(t)
+
+	require.NoError(t, c.AddSpot("DL1ABC", client.ModeCW, 7010000, client.NewARGB(255, 0, 0, 0), "5nn, tu; 73"))
+	received := server.Received()
+	require.Len(t, received, 1)
+	assert.Equal(t, "5nn, tu; 73", received[0].Args()[4])
+
+	assert.ErrorIs(t, c.AddSpot("DL1ABC", client.ModeCW, 7010000, client.NewARGB(255, 0, 0, 0), "~~~"), client.ErrInvalidArgument)
+	assert.ErrorIs(t, c.AddSpot("", client.ModeCW, 7010000, client.NewARGB(255, 0, 0, 0), "test"), client.ErrInvalidArgument)
+	assert.Len(t, server.Received(), 1)
+}
+
+func TestBinaryMessages_SampleBufferPooling(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	received := make(chan []float32, 3)
+	c, err := client.OpenURL(server.URL(), client.WithSampleBufferPooling(true), client.WithListeners(client.IQDataListenerFunc(func(_ int, _ client.IQSampleRate, data []float32) {
+		received <- append([]float32(nil), data...)
+	})))
+	require.NoError(t, err)
+	defer c.Disconnect()
+
+	frames := [][]float32{{0.5, -0.5}, {0.25, 0.25, 0.25}, {-1}}
+	for _, frame := range frames {
+		require.NoError(t, server.SendIQData(0, client.IQSampleRate48k, frame))
+	}
+
+	for _, frame := range frames {
+		assert.Equal(t, frame, <-received)
+	}
+}
+
+func TestEvents_SampleBufferPooling(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c, err := client.OpenURL(server.URL(), client.WithSampleBufferPooling(true))
+	require.NoError(t, err)
+	defer c.Disconnect()
+	events := c.Events(client.EventStreamConfig{Names: []string{"iq_stream"}})
+	defer events.Close()
+
+	frames := make([][]float32, 30)
+	for i := range frames {
+		frames[i] = []float32{float32(i) / 100, -float32(i) / 100}
+		require.NoError(t, server.SendIQData(0, client.IQSampleRate48k, frames[i]))
+		time.Sleep(time.Millisecond)
+	}
+
+	for _, frame := range frames {
+		event := (<-events.C).(client.IQDataEvent)
+		assert.Equal(t, frame, event.Data)
+	}
+}
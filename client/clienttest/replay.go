@@ -0,0 +1,127 @@
+package clienttest
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/ftl/tci/client"
+)
+
+// ReplayServer is a fake TCI server that replays the incoming frames of a recording (see client.Recorder) to every
+// new connection. Messages from the clients are collected, but never answered.
+type ReplayServer struct {
+	httpServer *httptest.Server
+	upgrader   websocket.Upgrader
+	frames     []client.Frame
+	speed      float64
+
+	mu       sync.Mutex
+	conns    map[*websocket.Conn]bool
+	received [][]byte
+}
+
+// NewReplayServer starts a new fake TCI server that replays the given frames with the given speed,
+// see client.Replay for the meaning of the speed.
+func NewReplayServer(frames []client.Frame, speed float64) *ReplayServer {
+	result := &ReplayServer{
+		frames: frames,
+		speed:  speed,
+		conns:  make(map[*websocket.Conn]bool),
+	}
+	result.httpServer = httptest.NewServer(http.HandlerFunc(result.serveWebsocket))
+	return result
+}
+
+// Close disconnects all clients and shuts the server down.
+func (s *ReplayServer) Close() {
+	s.mu.Lock()
+	for conn := range s.conns {
+		conn.Close()
+		delete(s.conns, conn)
+	}
+	s.mu.Unlock()
+	s.httpServer.Close()
+}
+
+// Addr returns the TCP address of the server.
+func (s *ReplayServer) Addr() *net.TCPAddr {
+	return s.httpServer.Listener.Addr().(*net.TCPAddr)
+}
+
+// URL returns the websocket URL of the server.
+func (s *ReplayServer) URL() string {
+	return "ws" + strings.TrimPrefix(s.httpServer.URL, "http")
+}
+
+// Received returns the payload of all frames that were received from the clients so far.
+func (s *ReplayServer) Received() [][]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := make([][]byte, len(s.received))
+	copy(result, s.received)
+	return result
+}
+
+func (s *ReplayServer) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	s.mu.Lock()
+	s.conns[conn] = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+	}()
+
+	done := make(chan struct{})
+	defer close(done)
+	go s.replay(conn, done)
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		s.mu.Lock()
+		s.received = append(s.received, data)
+		s.mu.Unlock()
+	}
+}
+
+func (s *ReplayServer) replay(conn *websocket.Conn, done <-chan struct{}) {
+	var previous client.Frame
+	for _, frame := range s.frames {
+		if frame.Direction != client.Incoming {
+			continue
+		}
+		delay := client.ReplayDelay(previous, frame, s.speed)
+		previous = frame
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-done:
+				return
+			}
+		}
+
+		messageType := websocket.TextMessage
+		if frame.Binary {
+			messageType = websocket.BinaryMessage
+		}
+		err := conn.WriteMessage(messageType, frame.Data)
+		if err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,414 @@
+/*
+The package clienttest provides a fake TCI server that can be used to test code built on top of the TCI client.
+
+The server sends an initial handshake to every new connection, echoes all incoming commands, keeps the
+state of the simulated radio, replies to requests from this state, and can emit binary frames (IQ data,
+RX audio, TX chrono) to all connected clients.
+
+The ReplayServer replays a recorded TCI communication (see client.Recorder) to every new connection.
+*/
+package clienttest
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/ftl/tci/client"
+)
+
+// DefaultHandshake returns the messages that are sent by default when a client connects, including the final READY; message.
+func DefaultHandshake() []client.Message {
+	return []client.Message{
+		client.NewCommandMessage("protocol", "ExpertSDR3", "1.8"),
+		client.NewCommandMessage("device", "SunSDR2PRO"),
+		client.NewCommandMessage("receive_only", false),
+		client.NewCommandMessage("trx_count", 2),
+		client.NewCommandMessage("channels_count", 2),
+		client.NewCommandMessage("vfo_limits", 10000, 30000000),
+		client.NewCommandMessage("if_limits", -48000, 48000),
+		client.NewCommandMessage("modulations_list", "am", "sam", "dsb", "lsb", "usb", "cw", "nfm", "digl", "digu", "wfm", "drm"),
+		client.NewCommandMessage("iq_samplerate", 48000),
+		client.NewCommandMessage("audio_samplerate", 48000),
+		client.NewCommandMessage("volume", -10),
+		client.NewCommandMessage("mute", false),
+		client.NewCommandMessage("dds", 0, 7000000),
+		client.NewCommandMessage("dds", 1, 14000000),
+		client.NewCommandMessage("if", 0, 0, 12000),
+		client.NewCommandMessage("if", 0, 1, 12500),
+		client.NewCommandMessage("if", 1, 0, 10000),
+		client.NewCommandMessage("if", 1, 1, 10000),
+		client.NewCommandMessage("vfo", 0, 0, 7012000),
+		client.NewCommandMessage("vfo", 0, 1, 7012500),
+		client.NewCommandMessage("vfo", 1, 0, 14010000),
+		client.NewCommandMessage("vfo", 1, 1, 14010000),
+		client.NewCommandMessage("modulation", 0, "cw"),
+		client.NewCommandMessage("modulation", 1, "usb"),
+		client.NewCommandMessage("rx_filter_band", 0, -250, 250),
+		client.NewCommandMessage("rx_filter_band", 1, 100, 2800),
+		client.NewCommandMessage("drive", 0, 50),
+		client.NewCommandMessage("drive", 1, 50),
+		client.NewCommandMessage("tune_drive", 0, 20),
+		client.NewCommandMessage("tune_drive", 1, 20),
+		client.NewCommandMessage("trx", 0, false),
+		client.NewCommandMessage("trx", 1, false),
+		client.NewCommandMessage("split_enable", 0, false),
+		client.NewCommandMessage("split_enable", 1, false),
+		client.NewCommandMessage("ready"),
+	}
+}
+
+// selectorCounts contains the number of leading arguments that select the entity (e.g. TRX, VFO) a message
+// refers to, for all messages where this is not simply all arguments but the last.
+var selectorCounts = map[string]int{
+	"protocol":          0,
+	"vfo_limits":        0,
+	"if_limits":         0,
+	"modulations_list":  0,
+	"rx_filter_band":    1,
+	"rx_nb_param":       1,
+	"trx":               1,
+	"tx_sensors":        1,
+	"rx_sensors_enable": 0,
+	"tx_sensors_enable": 0,
+	"spot":              1,
+}
+
+// stateless messages are never stored in the state of the simulated radio.
+var stateless = map[string]bool{
+	"ready":              true,
+	"start":              true,
+	"stop":               true,
+	"spot_delete":        true,
+	"spot_clear":         true,
+	"cw_macros":          true,
+	"cw_msg":             true,
+	"callsign_send":      true,
+	"cw_macros_stop":     true,
+	"iq_start":           true,
+	"iq_stop":            true,
+	"audio_start":        true,
+	"audio_stop":         true,
+	"set_in_focus":       true,
+	"clicked_on_spot":    true,
+	"rx_clicked_on_spot": true,
+}
+
+// Server is a fake TCI server.
+type Server struct {
+	httpServer *httptest.Server
+	upgrader   websocket.Upgrader
+
+	mu             sync.Mutex
+	state          []client.Message
+	conns          map[*serverConn]bool
+	received       []client.Message
+	receivedBinary [][]byte
+	silenced       map[string]bool
+	connected      chan struct{}
+}
+
+type serverConn struct {
+	*websocket.Conn
+	writeLock sync.Mutex
+}
+
+func (c *serverConn) write(messageType int, data []byte) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	return c.WriteMessage(messageType, data)
+}
+
+// NewServer starts a new fake TCI server that sends the given handshake to every new connection.
+// If no handshake is given, DefaultHandshake is used.
+func NewServer(handshake ...client.Message) *Server {
+	if len(handshake) == 0 {
+		handshake = DefaultHandshake()
+	}
+	result := &Server{
+		conns:     make(map[*serverConn]bool),
+		silenced:  make(map[string]bool),
+		connected: make(chan struct{}, 1),
+	}
+	for _, msg := range handshake {
+		result.updateState(msg)
+	}
+	result.httpServer = httptest.NewServer(http.HandlerFunc(result.serveWebsocket))
+	return result
+}
+
+// Close disconnects all clients and shuts the server down.
+func (s *Server) Close() {
+	s.DisconnectClients()
+	s.httpServer.Close()
+}
+
+// Addr returns the TCP address of the server.
+func (s *Server) Addr() *net.TCPAddr {
+	return s.httpServer.Listener.Addr().(*net.TCPAddr)
+}
+
+// URL returns the websocket URL of the server.
+func (s *Server) URL() string {
+	return "ws" + strings.TrimPrefix(s.httpServer.URL, "http")
+}
+
+// WaitForConnection waits until the next client connected and received the handshake.
+func (s *Server) WaitForConnection(timeout time.Duration) error {
+	select {
+	case <-s.connected:
+		return nil
+	case <-time.After(timeout):
+		return fmt.Errorf("no client connected within %v", timeout)
+	}
+}
+
+// DisconnectClients closes all open client connections.
+func (s *Server) DisconnectClients() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for conn := range s.conns {
+		conn.Close()
+		delete(s.conns, conn)
+	}
+}
+
+// Silence lets the server ignore all incoming messages with the given name, i.e. they are neither answered nor echoed.
+func (s *Server) Silence(name string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.silenced[strings.ToLower(name)] = true
+}
+
+// Received returns all text messages that were received from the clients so far.
+func (s *Server) Received() []client.Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := make([]client.Message, len(s.received))
+	copy(result, s.received)
+	return result
+}
+
+// ReceivedBinary returns all binary messages that were received from the clients so far.
+func (s *Server) ReceivedBinary() [][]byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	result := make([][]byte, len(s.receivedBinary))
+	copy(result, s.receivedBinary)
+	return result
+}
+
+// State returns the current state of the simulated radio for the given message name and selector arguments (e.g. TRX, VFO).
+func (s *Server) State(name string, selectors ...interface{}) (client.Message, bool) {
+	request := client.NewRequestMessage(name, selectors...)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, msg := range s.state {
+		if msg.Name() == request.Name() && msg.IsReplyTo(request) {
+			return msg, true
+		}
+	}
+	return client.Message{}, false
+}
+
+// Send updates the state of the simulated radio with the given message and sends the message to all connected clients.
+func (s *Server) Send(msg client.Message) error {
+	s.mu.Lock()
+	s.updateState(msg)
+	s.mu.Unlock()
+	return s.broadcast(websocket.TextMessage, []byte(msg.String()))
+}
+
+// SendIQData sends the given IQ samples for the given TRX to all connected clients.
+func (s *Server) SendIQData(trx int, sampleRate client.IQSampleRate, samples []float32) error {
+	return s.broadcast(websocket.BinaryMessage, BinaryFrame(client.IQStreamMessage, trx, int(sampleRate), samples))
+}
+
+// SendRXAudio sends the given audio samples for the given TRX to all connected clients.
+// The samples are encoded with the sample type that was selected with the AUDIO_STREAM_SAMPLE_TYPE command, float32 by default.
+func (s *Server) SendRXAudio(trx int, sampleRate client.AudioSampleRate, samples []float32) error {
+	sampleType := client.SampleTypeFloat32
+	if msg, ok := s.State("audio_stream_sample_type"); ok {
+		name, _ := msg.ToString(0)
+		if t, err := client.ParseSampleType(name); err == nil {
+			sampleType = t
+		}
+	}
+	return s.broadcast(websocket.BinaryMessage, BinaryFrameWithSampleType(client.RXAudioStreamMessage, trx, int(sampleRate), sampleType, samples))
+}
+
+// SendTXChrono requests the given number of TX audio samples for the given TRX from all connected clients.
+func (s *Server) SendTXChrono(trx int, sampleRate client.AudioSampleRate, requestedSampleCount int) error {
+	frame, _ := client.BinaryMessage{
+		TRX:        trx,
+		SampleRate: int(sampleRate),
+		Format:     int(client.SampleTypeFloat32),
+		DataLength: uint32(requestedSampleCount),
+		Type:       client.TXChronoMessage,
+	}.Bytes()
+	return s.broadcast(websocket.BinaryMessage, frame)
+}
+
+// BinaryFrame encodes the given samples as binary TCI message of the given type using 32-bit float samples.
+func BinaryFrame(messageType client.BinaryMessageType, trx int, sampleRate int, samples []float32) []byte {
+	return BinaryFrameWithSampleType(messageType, trx, sampleRate, client.SampleTypeFloat32, samples)
+}
+
+// BinaryFrameWithSampleType encodes the given samples as binary TCI message of the given type using the given sample type.
+func BinaryFrameWithSampleType(messageType client.BinaryMessageType, trx int, sampleRate int, sampleType client.SampleType, samples []float32) []byte {
+	frame, err := client.BinaryMessage{
+		TRX:        trx,
+		SampleRate: sampleRate,
+		Format:     int(sampleType),
+		DataLength: uint32(len(samples)),
+		Type:       messageType,
+		Data:       samples,
+	}.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	return frame
+}
+
+func (s *Server) broadcast(messageType int, data []byte) error {
+	s.mu.Lock()
+	conns := make([]*serverConn, 0, len(s.conns))
+	for conn := range s.conns {
+		conns = append(conns, conn)
+	}
+	s.mu.Unlock()
+
+	for _, conn := range conns {
+		err := conn.write(messageType, data)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	wsConn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	conn := &serverConn{Conn: wsConn}
+	defer conn.Close()
+
+	s.mu.Lock()
+	handshake := s.currentHandshake()
+	s.conns[conn] = true
+	s.mu.Unlock()
+	defer func() {
+		s.mu.Lock()
+		delete(s.conns, conn)
+		s.mu.Unlock()
+	}()
+
+	for _, msg := range handshake {
+		err := conn.write(websocket.TextMessage, []byte(msg.String()))
+		if err != nil {
+			return
+		}
+	}
+	select {
+	case s.connected <- struct{}{}:
+	default:
+	}
+
+	for {
+		messageType, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		switch messageType {
+		case websocket.TextMessage:
+			s.handleTextMessage(conn, string(data))
+		case websocket.BinaryMessage:
+			s.mu.Lock()
+			s.receivedBinary = append(s.receivedBinary, data)
+			s.mu.Unlock()
+		}
+	}
+}
+
+// currentHandshake returns the current state of the simulated radio, followed by the READY; message.
+// As the state is initialized from the handshake, the device information comes first.
+func (s *Server) currentHandshake() []client.Message {
+	result := make([]client.Message, 0, len(s.state)+1)
+	result = append(result, s.state...)
+	return append(result, client.NewCommandMessage("ready"))
+}
+
+func (s *Server) handleTextMessage(conn *serverConn, data string) {
+	msg, err := client.ParseTextMessage(data)
+	if err != nil {
+		return
+	}
+
+	s.mu.Lock()
+	s.received = append(s.received, msg)
+	if s.silenced[msg.Name()] {
+		s.mu.Unlock()
+		return
+	}
+	reply, isRequest := s.findState(msg)
+	if !isRequest {
+		s.updateState(msg)
+		reply = msg
+	}
+	s.mu.Unlock()
+
+	if isRequest {
+		conn.write(websocket.TextMessage, []byte(reply.String()))
+	} else {
+		s.broadcast(websocket.TextMessage, []byte(reply.String()))
+	}
+}
+
+func (s *Server) findState(request client.Message) (client.Message, bool) {
+	for _, msg := range s.state {
+		if msg.Name() == request.Name() && len(msg.Args()) > len(request.Args()) && msg.IsReplyTo(request) {
+			return msg, true
+		}
+	}
+	return client.Message{}, false
+}
+
+func (s *Server) updateState(msg client.Message) {
+	if stateless[msg.Name()] {
+		return
+	}
+	selectorCount, ok := selectorCounts[msg.Name()]
+	if !ok {
+		selectorCount = len(msg.Args()) - 1
+	}
+	if selectorCount < 0 {
+		selectorCount = 0
+	}
+	for i, stored := range s.state {
+		if stored.Name() == msg.Name() && sameSelectors(stored, msg, selectorCount) {
+			s.state[i] = msg
+			return
+		}
+	}
+	s.state = append(s.state, msg)
+}
+
+func sameSelectors(a, b client.Message, count int) bool {
+	if len(a.Args()) < count || len(b.Args()) < count {
+		return false
+	}
+	for i := 0; i < count; i++ {
+		if a.Args()[i] != b.Args()[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,1389 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package client
+
+import "context"
+
+// Start starts the SDR operation.
+func (c *Client) Start() error {
+	return c.StartContext(context.Background())
+}
+
+// StartContext is like Start, but uses the given context.
+func (c *Client) StartContext(ctx context.Context) error {
+	_, err := c.command(ctx, "start")
+	return err
+}
+
+// Stop stops the SDR operation.
+func (c *Client) Stop() error {
+	return c.StopContext(context.Background())
+}
+
+// StopContext is like Stop, but uses the given context.
+func (c *Client) StopContext(ctx context.Context) error {
+	_, err := c.command(ctx, "stop")
+	return err
+}
+
+// SetDDS sets the center frequency of the given TRX's panorama.
+func (c *Client) SetDDS(trx int, frequency int) error {
+	return c.SetDDSContext(context.Background(), trx, frequency)
+}
+
+// SetDDSContext is like SetDDS, but uses the given context.
+func (c *Client) SetDDSContext(ctx context.Context, trx int, frequency int) error {
+	_, err := c.command(ctx, "dds", trx, frequency)
+	return err
+}
+
+// DDS reads the center frequency of the given TRX's panorama.
+func (c *Client) DDS(trx int) (int, error) {
+	return c.DDSContext(context.Background(), trx)
+}
+
+// DDSContext is like DDS, but uses the given context.
+func (c *Client) DDSContext(ctx context.Context, trx int) (int, error) {
+	reply, err := c.request(ctx, "dds", trx)
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(1)
+}
+
+// SetIF sets the tuning frequency of the given TRX's vfo.
+func (c *Client) SetIF(trx int, vfo VFO, frequency int) error {
+	return c.SetIFContext(context.Background(), trx, vfo, frequency)
+}
+
+// SetIFContext is like SetIF, but uses the given context.
+func (c *Client) SetIFContext(ctx context.Context, trx int, vfo VFO, frequency int) error {
+	_, err := c.command(ctx, "if", trx, vfo, frequency)
+	return err
+}
+
+// IF reads the tuning frequency of the given TRX's vfo.
+func (c *Client) IF(trx int, vfo VFO) (int, error) {
+	return c.IFContext(context.Background(), trx, vfo)
+}
+
+// IFContext is like IF, but uses the given context.
+func (c *Client) IFContext(ctx context.Context, trx int, vfo VFO) (int, error) {
+	reply, err := c.request(ctx, "if", trx, vfo)
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(2)
+}
+
+// SetRITEnable enables the RIT of the given TRX.
+func (c *Client) SetRITEnable(trx int, enabled bool) error {
+	return c.SetRITEnableContext(context.Background(), trx, enabled)
+}
+
+// SetRITEnableContext is like SetRITEnable, but uses the given context.
+func (c *Client) SetRITEnableContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "rit_enable", trx, enabled)
+	return err
+}
+
+// RITEnable reads the RIT enable state of the given TRX.
+func (c *Client) RITEnable(trx int) (bool, error) {
+	return c.RITEnableContext(context.Background(), trx)
+}
+
+// RITEnableContext is like RITEnable, but uses the given context.
+func (c *Client) RITEnableContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "rit_enable", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetMode sets the mode of the given TRX.
+func (c *Client) SetMode(trx int, mode Mode) error {
+	return c.SetModeContext(context.Background(), trx, mode)
+}
+
+// SetModeContext is like SetMode, but uses the given context.
+func (c *Client) SetModeContext(ctx context.Context, trx int, mode Mode) error {
+	_, err := c.command(ctx, "modulation", trx, mode)
+	return err
+}
+
+// Mode reads the mode of the given TRX.
+func (c *Client) Mode(trx int) (Mode, error) {
+	return c.ModeContext(context.Background(), trx)
+}
+
+// ModeContext is like Mode, but uses the given context.
+func (c *Client) ModeContext(ctx context.Context, trx int) (Mode, error) {
+	reply, err := c.request(ctx, "modulation", trx)
+	if err != nil {
+		return "", err
+	}
+	return reply.toMode(1)
+}
+
+// SetRXEnable enables the RX of the given TRX.
+func (c *Client) SetRXEnable(trx int, enabled bool) error {
+	return c.SetRXEnableContext(context.Background(), trx, enabled)
+}
+
+// SetRXEnableContext is like SetRXEnable, but uses the given context.
+func (c *Client) SetRXEnableContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "rx_enable", trx, enabled)
+	return err
+}
+
+// RXEnable reads the RX enable state of the given TRX.
+func (c *Client) RXEnable(trx int) (bool, error) {
+	return c.RXEnableContext(context.Background(), trx)
+}
+
+// RXEnableContext is like RXEnable, but uses the given context.
+func (c *Client) RXEnableContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "rx_enable", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetXITEnable enables the XIT of the given TRX.
+func (c *Client) SetXITEnable(trx int, enabled bool) error {
+	return c.SetXITEnableContext(context.Background(), trx, enabled)
+}
+
+// SetXITEnableContext is like SetXITEnable, but uses the given context.
+func (c *Client) SetXITEnableContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "xit_enable", trx, enabled)
+	return err
+}
+
+// XITEnable reads the XIT enable state of the given TRX.
+func (c *Client) XITEnable(trx int) (bool, error) {
+	return c.XITEnableContext(context.Background(), trx)
+}
+
+// XITEnableContext is like XITEnable, but uses the given context.
+func (c *Client) XITEnableContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "xit_enable", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetSplitEnable enables the split mode of the given TRX. When split mode is enabled, VFOB is used for transmitting.
+func (c *Client) SetSplitEnable(trx int, enabled bool) error {
+	return c.SetSplitEnableContext(context.Background(), trx, enabled)
+}
+
+// SetSplitEnableContext is like SetSplitEnable, but uses the given context.
+func (c *Client) SetSplitEnableContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "split_enable", trx, enabled)
+	return err
+}
+
+// SplitEnable reads the split mode enable state of the given TRX. When split mode is enabled, VFOB is used for transmitting.
+func (c *Client) SplitEnable(trx int) (bool, error) {
+	return c.SplitEnableContext(context.Background(), trx)
+}
+
+// SplitEnableContext is like SplitEnable, but uses the given context.
+func (c *Client) SplitEnableContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "split_enable", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetRITOffset sets the RIT offset in Hz for the given TRX.
+func (c *Client) SetRITOffset(trx int, offset int) error {
+	return c.SetRITOffsetContext(context.Background(), trx, offset)
+}
+
+// SetRITOffsetContext is like SetRITOffset, but uses the given context.
+func (c *Client) SetRITOffsetContext(ctx context.Context, trx int, offset int) error {
+	_, err := c.command(ctx, "rit_offset", trx, offset)
+	return err
+}
+
+// RITOffset reads the RIT offset in Hz for the given TRX.
+func (c *Client) RITOffset(trx int) (int, error) {
+	return c.RITOffsetContext(context.Background(), trx)
+}
+
+// RITOffsetContext is like RITOffset, but uses the given context.
+func (c *Client) RITOffsetContext(ctx context.Context, trx int) (int, error) {
+	reply, err := c.request(ctx, "rit_offset", trx)
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(1)
+}
+
+// SetXITOffset sets the XIT offset in Hz for the given TRX.
+func (c *Client) SetXITOffset(trx int, offset int) error {
+	return c.SetXITOffsetContext(context.Background(), trx, offset)
+}
+
+// SetXITOffsetContext is like SetXITOffset, but uses the given context.
+func (c *Client) SetXITOffsetContext(ctx context.Context, trx int, offset int) error {
+	_, err := c.command(ctx, "xit_offset", trx, offset)
+	return err
+}
+
+// XITOffset reads the XIT offset in Hz for the given TRX.
+func (c *Client) XITOffset(trx int) (int, error) {
+	return c.XITOffsetContext(context.Background(), trx)
+}
+
+// XITOffsetContext is like XITOffset, but uses the given context.
+func (c *Client) XITOffsetContext(ctx context.Context, trx int) (int, error) {
+	reply, err := c.request(ctx, "xit_offset", trx)
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(1)
+}
+
+// SetRXChannelEnable enables the given TRX's additional RX channel with the given index.
+func (c *Client) SetRXChannelEnable(trx int, vfo VFO, enabled bool) error {
+	return c.SetRXChannelEnableContext(context.Background(), trx, vfo, enabled)
+}
+
+// SetRXChannelEnableContext is like SetRXChannelEnable, but uses the given context.
+func (c *Client) SetRXChannelEnableContext(ctx context.Context, trx int, vfo VFO, enabled bool) error {
+	_, err := c.command(ctx, "rx_channel_enable", trx, vfo, enabled)
+	return err
+}
+
+// RXChannelEnable reads the enable state of the given TRX's additional RX channel with the given index.
+func (c *Client) RXChannelEnable(trx int, vfo VFO) (bool, error) {
+	return c.RXChannelEnableContext(context.Background(), trx, vfo)
+}
+
+// RXChannelEnableContext is like RXChannelEnable, but uses the given context.
+func (c *Client) RXChannelEnableContext(ctx context.Context, trx int, vfo VFO) (bool, error) {
+	reply, err := c.request(ctx, "rx_channel_enable", trx, vfo)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(2)
+}
+
+// SetRXFilterBand sets the IF filter boundaries of the given TRX using the given limit frequencies in Hz.
+func (c *Client) SetRXFilterBand(trx int, min int, max int) error {
+	return c.SetRXFilterBandContext(context.Background(), trx, min, max)
+}
+
+// SetRXFilterBandContext is like SetRXFilterBand, but uses the given context.
+func (c *Client) SetRXFilterBandContext(ctx context.Context, trx int, min int, max int) error {
+	_, err := c.command(ctx, "rx_filter_band", trx, min, max)
+	return err
+}
+
+// RXFilterBand reads the IF filter boundaries of the given TRX.
+func (c *Client) RXFilterBand(trx int) (int, int, error) {
+	return c.RXFilterBandContext(context.Background(), trx)
+}
+
+// RXFilterBandContext is like RXFilterBand, but uses the given context.
+func (c *Client) RXFilterBandContext(ctx context.Context, trx int) (int, int, error) {
+	reply, err := c.request(ctx, "rx_filter_band", trx)
+	if err != nil {
+		return 0, 0, err
+	}
+	min, err := reply.ToInt(1)
+	if err != nil {
+		return 0, 0, err
+	}
+	max, err := reply.ToInt(2)
+	if err != nil {
+		return 0, 0, err
+	}
+	return min, max, nil
+}
+
+// SetRXSMeter sets the signal level of the given TRX's RX channel with the given index.
+func (c *Client) SetRXSMeter(trx int, vfo VFO, level int) error {
+	return c.SetRXSMeterContext(context.Background(), trx, vfo, level)
+}
+
+// SetRXSMeterContext is like SetRXSMeter, but uses the given context.
+func (c *Client) SetRXSMeterContext(ctx context.Context, trx int, vfo VFO, level int) error {
+	_, err := c.command(ctx, "rx_smeter", trx, vfo, level)
+	return err
+}
+
+// RXSMeter reads the signal level of the given TRX's RX channel with the given index.
+func (c *Client) RXSMeter(trx int, vfo VFO) (int, error) {
+	return c.RXSMeterContext(context.Background(), trx, vfo)
+}
+
+// RXSMeterContext is like RXSMeter, but uses the given context.
+func (c *Client) RXSMeterContext(ctx context.Context, trx int, vfo VFO) (int, error) {
+	reply, err := c.request(ctx, "rx_smeter", trx, vfo)
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(2)
+}
+
+// SetCWMacrosSpeed sets the speed in WPM for CW macros.
+func (c *Client) SetCWMacrosSpeed(wpm int) error {
+	return c.SetCWMacrosSpeedContext(context.Background(), wpm)
+}
+
+// SetCWMacrosSpeedContext is like SetCWMacrosSpeed, but uses the given context.
+func (c *Client) SetCWMacrosSpeedContext(ctx context.Context, wpm int) error {
+	_, err := c.command(ctx, "cw_macros_speed", wpm)
+	return err
+}
+
+// CWMacrosSpeed reads the speed in WPM for CW macros.
+func (c *Client) CWMacrosSpeed() (int, error) {
+	return c.CWMacrosSpeedContext(context.Background())
+}
+
+// CWMacrosSpeedContext is like CWMacrosSpeed, but uses the given context.
+func (c *Client) CWMacrosSpeedContext(ctx context.Context) (int, error) {
+	reply, err := c.request(ctx, "cw_macros_speed")
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(0)
+}
+
+// SetCWMacrosDelay sets the delay between keying the TRX and transmitting a CW macros in milliseconds.
+func (c *Client) SetCWMacrosDelay(delay int) error {
+	return c.SetCWMacrosDelayContext(context.Background(), delay)
+}
+
+// SetCWMacrosDelayContext is like SetCWMacrosDelay, but uses the given context.
+func (c *Client) SetCWMacrosDelayContext(ctx context.Context, delay int) error {
+	_, err := c.command(ctx, "cw_macros_delay", delay)
+	return err
+}
+
+// CWMacrosDelay reads the delay for transmitting CW macros in milliseconds.
+func (c *Client) CWMacrosDelay() (int, error) {
+	return c.CWMacrosDelayContext(context.Background())
+}
+
+// CWMacrosDelayContext is like CWMacrosDelay, but uses the given context.
+func (c *Client) CWMacrosDelayContext(ctx context.Context) (int, error) {
+	reply, err := c.request(ctx, "cw_macros_delay")
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(0)
+}
+
+// TX reads the current state of the given TRX's transmitter.
+func (c *Client) TX(trx int) (bool, error) {
+	return c.TXContext(context.Background(), trx)
+}
+
+// TXContext is like TX, but uses the given context.
+func (c *Client) TXContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "trx", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetTune enables the given TRX's transmitter in tuning.
+func (c *Client) SetTune(trx int, enabled bool) error {
+	return c.SetTuneContext(context.Background(), trx, enabled)
+}
+
+// SetTuneContext is like SetTune, but uses the given context.
+func (c *Client) SetTuneContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "tune", trx, enabled)
+	return err
+}
+
+// Tune reads the current state of the given TRX's tuning transmitter.
+func (c *Client) Tune(trx int) (bool, error) {
+	return c.TuneContext(context.Background(), trx)
+}
+
+// TuneContext is like Tune, but uses the given context.
+func (c *Client) TuneContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "tune", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// IQSampleRate reads the sample rate for IQ data.
+func (c *Client) IQSampleRate() (IQSampleRate, error) {
+	return c.IQSampleRateContext(context.Background())
+}
+
+// IQSampleRateContext is like IQSampleRate, but uses the given context.
+func (c *Client) IQSampleRateContext(ctx context.Context) (IQSampleRate, error) {
+	reply, err := c.request(ctx, "iq_samplerate")
+	if err != nil {
+		return 0, err
+	}
+	sampleRate, err := reply.ToInt(0)
+	return IQSampleRate(sampleRate), err
+}
+
+// AudioSampleRate reads the sample rate for Audio data.
+func (c *Client) AudioSampleRate() (AudioSampleRate, error) {
+	return c.AudioSampleRateContext(context.Background())
+}
+
+// AudioSampleRateContext is like AudioSampleRate, but uses the given context.
+func (c *Client) AudioSampleRateContext(ctx context.Context) (AudioSampleRate, error) {
+	reply, err := c.request(ctx, "audio_samplerate")
+	if err != nil {
+		return 0, err
+	}
+	sampleRate, err := reply.ToInt(0)
+	return AudioSampleRate(sampleRate), err
+}
+
+// AudioStreamSampleType reads the sample type for the RX audio stream. (since TCI 1.6)
+func (c *Client) AudioStreamSampleType() (SampleType, error) {
+	return c.AudioStreamSampleTypeContext(context.Background())
+}
+
+// AudioStreamSampleTypeContext is like AudioStreamSampleType, but uses the given context.
+func (c *Client) AudioStreamSampleTypeContext(ctx context.Context) (SampleType, error) {
+	reply, err := c.request(ctx, "audio_stream_sample_type")
+	if err != nil {
+		return 0, err
+	}
+	return reply.toSampleType(0)
+}
+
+// AudioStreamChannels reads the number of channels for the RX audio stream. (since TCI 1.6)
+func (c *Client) AudioStreamChannels() (int, error) {
+	return c.AudioStreamChannelsContext(context.Background())
+}
+
+// AudioStreamChannelsContext is like AudioStreamChannels, but uses the given context.
+func (c *Client) AudioStreamChannelsContext(ctx context.Context) (int, error) {
+	reply, err := c.request(ctx, "audio_stream_channels")
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(0)
+}
+
+// SetVolume sets the main volume in dB (range from -60dB to 0dB).
+func (c *Client) SetVolume(dB int) error {
+	return c.SetVolumeContext(context.Background(), dB)
+}
+
+// SetVolumeContext is like SetVolume, but uses the given context.
+func (c *Client) SetVolumeContext(ctx context.Context, dB int) error {
+	if err := checkRange("volume", "dB", dB, -60, 0); err != nil {
+		return err
+	}
+	_, err := c.command(ctx, "volume", dB)
+	return err
+}
+
+// Volume reads the main volume in dB (range from -60dB to 0dB).
+func (c *Client) Volume() (int, error) {
+	return c.VolumeContext(context.Background())
+}
+
+// VolumeContext is like Volume, but uses the given context.
+func (c *Client) VolumeContext(ctx context.Context) (int, error) {
+	reply, err := c.request(ctx, "volume")
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(0)
+}
+
+// SetSquelchEnable enables the given TRX's squelch.
+func (c *Client) SetSquelchEnable(trx int, enabled bool) error {
+	return c.SetSquelchEnableContext(context.Background(), trx, enabled)
+}
+
+// SetSquelchEnableContext is like SetSquelchEnable, but uses the given context.
+func (c *Client) SetSquelchEnableContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "sql_enable", trx, enabled)
+	return err
+}
+
+// SquelchEnable reads the enable state of the given TRX's squelch.
+func (c *Client) SquelchEnable(trx int) (bool, error) {
+	return c.SquelchEnableContext(context.Background(), trx)
+}
+
+// SquelchEnableContext is like SquelchEnable, but uses the given context.
+func (c *Client) SquelchEnableContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "sql_enable", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetSquelchLevel sets the squelch threshold in dB (range from -140dB to 0dB).
+func (c *Client) SetSquelchLevel(dB int) error {
+	return c.SetSquelchLevelContext(context.Background(), dB)
+}
+
+// SetSquelchLevelContext is like SetSquelchLevel, but uses the given context.
+func (c *Client) SetSquelchLevelContext(ctx context.Context, dB int) error {
+	if err := checkRange("sql_level", "dB", dB, -140, 0); err != nil {
+		return err
+	}
+	_, err := c.command(ctx, "sql_level", dB)
+	return err
+}
+
+// SquelchLevel reads the squelch threshold in dB (range from -140dB to 0dB).
+func (c *Client) SquelchLevel() (int, error) {
+	return c.SquelchLevelContext(context.Background())
+}
+
+// SquelchLevelContext is like SquelchLevel, but uses the given context.
+func (c *Client) SquelchLevelContext(ctx context.Context) (int, error) {
+	reply, err := c.request(ctx, "sql_level")
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(0)
+}
+
+// SetVFOFrequency sets the tuning frequency of the given TRX's vfo.
+func (c *Client) SetVFOFrequency(trx int, vfo VFO, frequency int) error {
+	return c.SetVFOFrequencyContext(context.Background(), trx, vfo, frequency)
+}
+
+// SetVFOFrequencyContext is like SetVFOFrequency, but uses the given context.
+func (c *Client) SetVFOFrequencyContext(ctx context.Context, trx int, vfo VFO, frequency int) error {
+	_, err := c.command(ctx, "vfo", trx, vfo, frequency)
+	return err
+}
+
+// VFOFrequency reads the tuning frequency of the given TRX's vfo.
+func (c *Client) VFOFrequency(trx int, vfo VFO) (int, error) {
+	return c.VFOFrequencyContext(context.Background(), trx, vfo)
+}
+
+// VFOFrequencyContext is like VFOFrequency, but uses the given context.
+func (c *Client) VFOFrequencyContext(ctx context.Context, trx int, vfo VFO) (int, error) {
+	reply, err := c.request(ctx, "vfo", trx, vfo)
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(2)
+}
+
+// SetMute mutes the main volume.
+func (c *Client) SetMute(muted bool) error {
+	return c.SetMuteContext(context.Background(), muted)
+}
+
+// SetMuteContext is like SetMute, but uses the given context.
+func (c *Client) SetMuteContext(ctx context.Context, muted bool) error {
+	_, err := c.command(ctx, "mute", muted)
+	return err
+}
+
+// Mute reads main volume's mute state.
+func (c *Client) Mute() (bool, error) {
+	return c.MuteContext(context.Background())
+}
+
+// MuteContext is like Mute, but uses the given context.
+func (c *Client) MuteContext(ctx context.Context) (bool, error) {
+	reply, err := c.request(ctx, "mute")
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(0)
+}
+
+// SetRXMute mutes the given TRX's receiver.
+func (c *Client) SetRXMute(trx int, muted bool) error {
+	return c.SetRXMuteContext(context.Background(), trx, muted)
+}
+
+// SetRXMuteContext is like SetRXMute, but uses the given context.
+func (c *Client) SetRXMuteContext(ctx context.Context, trx int, muted bool) error {
+	_, err := c.command(ctx, "rx_mute", trx, muted)
+	return err
+}
+
+// RXMute reads given TRX's receiver mute state.
+func (c *Client) RXMute(trx int) (bool, error) {
+	return c.RXMuteContext(context.Background(), trx)
+}
+
+// RXMuteContext is like RXMute, but uses the given context.
+func (c *Client) RXMuteContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "rx_mute", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetCTCSSEnable enables CTCSS for the given TRX.
+func (c *Client) SetCTCSSEnable(trx int, enabled bool) error {
+	return c.SetCTCSSEnableContext(context.Background(), trx, enabled)
+}
+
+// SetCTCSSEnableContext is like SetCTCSSEnable, but uses the given context.
+func (c *Client) SetCTCSSEnableContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "ctcss_enable", trx, enabled)
+	return err
+}
+
+// CTCSSEnable reads enable state of CTCSS for the given TRX.
+func (c *Client) CTCSSEnable(trx int) (bool, error) {
+	return c.CTCSSEnableContext(context.Background(), trx)
+}
+
+// CTCSSEnableContext is like CTCSSEnable, but uses the given context.
+func (c *Client) CTCSSEnableContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "ctcss_enable", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetCTCSSMode sets the CTCSS mode of the given TRX.
+func (c *Client) SetCTCSSMode(trx int, mode CTCSSMode) error {
+	return c.SetCTCSSModeContext(context.Background(), trx, mode)
+}
+
+// SetCTCSSModeContext is like SetCTCSSMode, but uses the given context.
+func (c *Client) SetCTCSSModeContext(ctx context.Context, trx int, mode CTCSSMode) error {
+	_, err := c.command(ctx, "ctcss_mode", trx, mode)
+	return err
+}
+
+// CTCSSMode reads the CTCSS mode of the given TRX.
+func (c *Client) CTCSSMode(trx int) (CTCSSMode, error) {
+	return c.CTCSSModeContext(context.Background(), trx)
+}
+
+// CTCSSModeContext is like CTCSSMode, but uses the given context.
+func (c *Client) CTCSSModeContext(ctx context.Context, trx int) (CTCSSMode, error) {
+	reply, err := c.request(ctx, "ctcss_mode", trx)
+	if err != nil {
+		return 0, err
+	}
+	mode, err := reply.ToInt(1)
+	return CTCSSMode(mode), err
+}
+
+// SetCTCSSRXTone sets the given TRX's CTCSS subtone for receiving.
+func (c *Client) SetCTCSSRXTone(trx int, tone CTCSSTone) error {
+	return c.SetCTCSSRXToneContext(context.Background(), trx, tone)
+}
+
+// SetCTCSSRXToneContext is like SetCTCSSRXTone, but uses the given context.
+func (c *Client) SetCTCSSRXToneContext(ctx context.Context, trx int, tone CTCSSTone) error {
+	_, err := c.command(ctx, "ctcss_rx_tone", trx, tone)
+	return err
+}
+
+// CTCSSRXTone reads the given TRX's CTCSS subtone for receiving.
+func (c *Client) CTCSSRXTone(trx int) (CTCSSTone, error) {
+	return c.CTCSSRXToneContext(context.Background(), trx)
+}
+
+// CTCSSRXToneContext is like CTCSSRXTone, but uses the given context.
+func (c *Client) CTCSSRXToneContext(ctx context.Context, trx int) (CTCSSTone, error) {
+	reply, err := c.request(ctx, "ctcss_rx_tone", trx)
+	if err != nil {
+		return 0, err
+	}
+	tone, err := reply.ToInt(1)
+	return CTCSSTone(tone), err
+}
+
+// SetCTCSSTXTone sets the given TRX's CTCSS subtone for transmitting.
+func (c *Client) SetCTCSSTXTone(trx int, tone CTCSSTone) error {
+	return c.SetCTCSSTXToneContext(context.Background(), trx, tone)
+}
+
+// SetCTCSSTXToneContext is like SetCTCSSTXTone, but uses the given context.
+func (c *Client) SetCTCSSTXToneContext(ctx context.Context, trx int, tone CTCSSTone) error {
+	_, err := c.command(ctx, "ctcss_tx_tone", trx, tone)
+	return err
+}
+
+// CTCSSTXTone reads the given TRX's CTCSS subtone for transmitting.
+func (c *Client) CTCSSTXTone(trx int) (CTCSSTone, error) {
+	return c.CTCSSTXToneContext(context.Background(), trx)
+}
+
+// CTCSSTXToneContext is like CTCSSTXTone, but uses the given context.
+func (c *Client) CTCSSTXToneContext(ctx context.Context, trx int) (CTCSSTone, error) {
+	reply, err := c.request(ctx, "ctcss_tx_tone", trx)
+	if err != nil {
+		return 0, err
+	}
+	tone, err := reply.ToInt(1)
+	return CTCSSTone(tone), err
+}
+
+// SetCTCSSLevel sets the given TRX's CTCSS subtone level for transmitting in percent.
+func (c *Client) SetCTCSSLevel(trx int, percent int) error {
+	return c.SetCTCSSLevelContext(context.Background(), trx, percent)
+}
+
+// SetCTCSSLevelContext is like SetCTCSSLevel, but uses the given context.
+func (c *Client) SetCTCSSLevelContext(ctx context.Context, trx int, percent int) error {
+	if err := checkRange("ctcss_level", "percent", percent, 0, 100); err != nil {
+		return err
+	}
+	_, err := c.command(ctx, "ctcss_level", trx, percent)
+	return err
+}
+
+// CTCSSLevel reads the given TRX's CTCSS subtone level for transmitting in percent.
+func (c *Client) CTCSSLevel(trx int) (int, error) {
+	return c.CTCSSLevelContext(context.Background(), trx)
+}
+
+// CTCSSLevelContext is like CTCSSLevel, but uses the given context.
+func (c *Client) CTCSSLevelContext(ctx context.Context, trx int) (int, error) {
+	reply, err := c.request(ctx, "ctcss_level", trx)
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(1)
+}
+
+// SetECoderSwitchRX assigns the given TRX's control to the given E-Coder.
+func (c *Client) SetECoderSwitchRX(ecoder int, trx int) error {
+	return c.SetECoderSwitchRXContext(context.Background(), ecoder, trx)
+}
+
+// SetECoderSwitchRXContext is like SetECoderSwitchRX, but uses the given context.
+func (c *Client) SetECoderSwitchRXContext(ctx context.Context, ecoder int, trx int) error {
+	_, err := c.command(ctx, "ecoder_switch_rx", ecoder, trx)
+	return err
+}
+
+// ECoderSwitchRX reads which TRX is assigned to the given E-Coder.
+func (c *Client) ECoderSwitchRX(ecoder int) (int, error) {
+	return c.ECoderSwitchRXContext(context.Background(), ecoder)
+}
+
+// ECoderSwitchRXContext is like ECoderSwitchRX, but uses the given context.
+func (c *Client) ECoderSwitchRXContext(ctx context.Context, ecoder int) (int, error) {
+	reply, err := c.request(ctx, "ecoder_switch_rx", ecoder)
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(1)
+}
+
+// SetECoderSwitchChannel assigns the given channel's control to the given E-Coder.
+func (c *Client) SetECoderSwitchChannel(ecoder int, vfo VFO) error {
+	return c.SetECoderSwitchChannelContext(context.Background(), ecoder, vfo)
+}
+
+// SetECoderSwitchChannelContext is like SetECoderSwitchChannel, but uses the given context.
+func (c *Client) SetECoderSwitchChannelContext(ctx context.Context, ecoder int, vfo VFO) error {
+	_, err := c.command(ctx, "ecoder_switch_channel", ecoder, vfo)
+	return err
+}
+
+// ECoderSwitchChannel reads which channel is assigned to the given E-Coder.
+func (c *Client) ECoderSwitchChannel(ecoder int) (VFO, error) {
+	return c.ECoderSwitchChannelContext(context.Background(), ecoder)
+}
+
+// ECoderSwitchChannelContext is like ECoderSwitchChannel, but uses the given context.
+func (c *Client) ECoderSwitchChannelContext(ctx context.Context, ecoder int) (VFO, error) {
+	reply, err := c.request(ctx, "ecoder_switch_channel", ecoder)
+	if err != nil {
+		return 0, err
+	}
+	vfo, err := reply.ToInt(1)
+	return VFO(vfo), err
+}
+
+// SetRXVolume sets the given TRX's channel volume in dB (range from -60dB to 0dB).
+func (c *Client) SetRXVolume(trx int, vfo VFO, dB int) error {
+	return c.SetRXVolumeContext(context.Background(), trx, vfo, dB)
+}
+
+// SetRXVolumeContext is like SetRXVolume, but uses the given context.
+func (c *Client) SetRXVolumeContext(ctx context.Context, trx int, vfo VFO, dB int) error {
+	if err := checkRange("rx_volume", "dB", dB, -60, 0); err != nil {
+		return err
+	}
+	_, err := c.command(ctx, "rx_volume", trx, vfo, dB)
+	return err
+}
+
+// RXVolume reads the given TRX's channel volume in dB (range from -60dB to 0dB).
+func (c *Client) RXVolume(trx int, vfo VFO) (int, error) {
+	return c.RXVolumeContext(context.Background(), trx, vfo)
+}
+
+// RXVolumeContext is like RXVolume, but uses the given context.
+func (c *Client) RXVolumeContext(ctx context.Context, trx int, vfo VFO) (int, error) {
+	reply, err := c.request(ctx, "rx_volume", trx, vfo)
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(2)
+}
+
+// SetRXBalance sets the given TRX's channel balance in dB (range from -40dB to 40dB).
+func (c *Client) SetRXBalance(trx int, vfo VFO, dB int) error {
+	return c.SetRXBalanceContext(context.Background(), trx, vfo, dB)
+}
+
+// SetRXBalanceContext is like SetRXBalance, but uses the given context.
+func (c *Client) SetRXBalanceContext(ctx context.Context, trx int, vfo VFO, dB int) error {
+	if err := checkRange("rx_balance", "dB", dB, -40, 40); err != nil {
+		return err
+	}
+	_, err := c.command(ctx, "rx_balance", trx, vfo, dB)
+	return err
+}
+
+// RXBalance reads the given TRX's channel balance in dB (range from -40dB to 40dB).
+func (c *Client) RXBalance(trx int, vfo VFO) (int, error) {
+	return c.RXBalanceContext(context.Background(), trx, vfo)
+}
+
+// RXBalanceContext is like RXBalance, but uses the given context.
+func (c *Client) RXBalanceContext(ctx context.Context, trx int, vfo VFO) (int, error) {
+	reply, err := c.request(ctx, "rx_balance", trx, vfo)
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(2)
+}
+
+// SetRXNBEnable enables/disables the given TRX's noise blanker. (since TCI 1.6)
+func (c *Client) SetRXNBEnable(trx int, enabled bool) error {
+	return c.SetRXNBEnableContext(context.Background(), trx, enabled)
+}
+
+// SetRXNBEnableContext is like SetRXNBEnable, but uses the given context.
+func (c *Client) SetRXNBEnableContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "rx_nb_enable", trx, enabled)
+	return err
+}
+
+// RXNBEnable reads the given TRX's noise blanker enable state. (since TCI 1.6)
+func (c *Client) RXNBEnable(trx int) (bool, error) {
+	return c.RXNBEnableContext(context.Background(), trx)
+}
+
+// RXNBEnableContext is like RXNBEnable, but uses the given context.
+func (c *Client) RXNBEnableContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "rx_nb_enable", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetRXNBParams sets the given TRX's noise blanker parameters. (since TCI 1.6)
+func (c *Client) SetRXNBParams(trx int, threshold int, impulseLength int) error {
+	return c.SetRXNBParamsContext(context.Background(), trx, threshold, impulseLength)
+}
+
+// SetRXNBParamsContext is like SetRXNBParams, but uses the given context.
+func (c *Client) SetRXNBParamsContext(ctx context.Context, trx int, threshold int, impulseLength int) error {
+	_, err := c.command(ctx, "rx_nb_param", trx, threshold, impulseLength)
+	return err
+}
+
+// RXNBParams reads the given TRX's noise blanker parameters. (since TCI 1.6)
+func (c *Client) RXNBParams(trx int) (int, int, error) {
+	return c.RXNBParamsContext(context.Background(), trx)
+}
+
+// RXNBParamsContext is like RXNBParams, but uses the given context.
+func (c *Client) RXNBParamsContext(ctx context.Context, trx int) (int, int, error) {
+	reply, err := c.request(ctx, "rx_nb_param", trx)
+	if err != nil {
+		return 0, 0, err
+	}
+	threshold, err := reply.ToInt(1)
+	if err != nil {
+		return 0, 0, err
+	}
+	impulseLength, err := reply.ToInt(2)
+	if err != nil {
+		return 0, 0, err
+	}
+	return threshold, impulseLength, nil
+}
+
+// SetRXBinEnable enables/disables the given TRX's pseudo stereo for CW. (since TCI 1.6)
+func (c *Client) SetRXBinEnable(trx int, enabled bool) error {
+	return c.SetRXBinEnableContext(context.Background(), trx, enabled)
+}
+
+// SetRXBinEnableContext is like SetRXBinEnable, but uses the given context.
+func (c *Client) SetRXBinEnableContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "rx_bin_enable", trx, enabled)
+	return err
+}
+
+// RXBinEnable reads the given TRX's pseudo stereo enable state. (since TCI 1.6)
+func (c *Client) RXBinEnable(trx int) (bool, error) {
+	return c.RXBinEnableContext(context.Background(), trx)
+}
+
+// RXBinEnableContext is like RXBinEnable, but uses the given context.
+func (c *Client) RXBinEnableContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "rx_bin_enable", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetRXNREnable enables/disables the given TRX's noise reduction. (since TCI 1.6)
+func (c *Client) SetRXNREnable(trx int, enabled bool) error {
+	return c.SetRXNREnableContext(context.Background(), trx, enabled)
+}
+
+// SetRXNREnableContext is like SetRXNREnable, but uses the given context.
+func (c *Client) SetRXNREnableContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "rx_nr_enable", trx, enabled)
+	return err
+}
+
+// RXNREnable reads the given TRX's noise reduction enable state. (since TCI 1.6)
+func (c *Client) RXNREnable(trx int) (bool, error) {
+	return c.RXNREnableContext(context.Background(), trx)
+}
+
+// RXNREnableContext is like RXNREnable, but uses the given context.
+func (c *Client) RXNREnableContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "rx_nr_enable", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetRXANCEnable enables/disables the given TRX's automatic noise cancellation. (since TCI 1.6)
+func (c *Client) SetRXANCEnable(trx int, enabled bool) error {
+	return c.SetRXANCEnableContext(context.Background(), trx, enabled)
+}
+
+// SetRXANCEnableContext is like SetRXANCEnable, but uses the given context.
+func (c *Client) SetRXANCEnableContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "rx_anc_enable", trx, enabled)
+	return err
+}
+
+// RXANCEnable reads the given TRX's automatic noise cancellation enable state. (since TCI 1.6)
+func (c *Client) RXANCEnable(trx int) (bool, error) {
+	return c.RXANCEnableContext(context.Background(), trx)
+}
+
+// RXANCEnableContext is like RXANCEnable, but uses the given context.
+func (c *Client) RXANCEnableContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "rx_anc_enable", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetRXANFEnable enables/disables the given TRX's automatic notch filter. (since TCI 1.6)
+func (c *Client) SetRXANFEnable(trx int, enabled bool) error {
+	return c.SetRXANFEnableContext(context.Background(), trx, enabled)
+}
+
+// SetRXANFEnableContext is like SetRXANFEnable, but uses the given context.
+func (c *Client) SetRXANFEnableContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "rx_anf_enable", trx, enabled)
+	return err
+}
+
+// RXANFEnable reads the given TRX's automatic notch filter enable state. (since TCI 1.6)
+func (c *Client) RXANFEnable(trx int) (bool, error) {
+	return c.RXANFEnableContext(context.Background(), trx)
+}
+
+// RXANFEnableContext is like RXANFEnable, but uses the given context.
+func (c *Client) RXANFEnableContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "rx_anf_enable", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetRXAPFEnable enables/disables the given TRX's analogue peak filter. (since TCI 1.6)
+func (c *Client) SetRXAPFEnable(trx int, enabled bool) error {
+	return c.SetRXAPFEnableContext(context.Background(), trx, enabled)
+}
+
+// SetRXAPFEnableContext is like SetRXAPFEnable, but uses the given context.
+func (c *Client) SetRXAPFEnableContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "rx_apf_enable", trx, enabled)
+	return err
+}
+
+// RXAPFEnable reads the given TRX's analogue peak filter enable state. (since TCI 1.6)
+func (c *Client) RXAPFEnable(trx int) (bool, error) {
+	return c.RXAPFEnableContext(context.Background(), trx)
+}
+
+// RXAPFEnableContext is like RXAPFEnable, but uses the given context.
+func (c *Client) RXAPFEnableContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "rx_apf_enable", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetRXDSEEnable enables/disables the given TRX's digital surround sound effect. (since TCI 1.6)
+func (c *Client) SetRXDSEEnable(trx int, enabled bool) error {
+	return c.SetRXDSEEnableContext(context.Background(), trx, enabled)
+}
+
+// SetRXDSEEnableContext is like SetRXDSEEnable, but uses the given context.
+func (c *Client) SetRXDSEEnableContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "rx_dse_enable", trx, enabled)
+	return err
+}
+
+// RXDSEEnable reads the given TRX's digital surround sound effect enable state. (since TCI 1.6)
+func (c *Client) RXDSEEnable(trx int) (bool, error) {
+	return c.RXDSEEnableContext(context.Background(), trx)
+}
+
+// RXDSEEnableContext is like RXDSEEnable, but uses the given context.
+func (c *Client) RXDSEEnableContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "rx_dse_enable", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetRXNFEnable enables/disables the given TRX's band notch filters. (since TCI 1.6)
+func (c *Client) SetRXNFEnable(trx int, enabled bool) error {
+	return c.SetRXNFEnableContext(context.Background(), trx, enabled)
+}
+
+// SetRXNFEnableContext is like SetRXNFEnable, but uses the given context.
+func (c *Client) SetRXNFEnableContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "rx_nf_enable", trx, enabled)
+	return err
+}
+
+// RXNFEnable reads the given TRX's band notch filters enable state. (since TCI 1.6)
+func (c *Client) RXNFEnable(trx int) (bool, error) {
+	return c.RXNFEnableContext(context.Background(), trx)
+}
+
+// RXNFEnableContext is like RXNFEnable, but uses the given context.
+func (c *Client) RXNFEnableContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "rx_nf_enable", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetAGCMode sets the AGC mode of the given TRX. (since TCI 1.8, unverified)
+func (c *Client) SetAGCMode(trx int, mode AGCMode) error {
+	return c.SetAGCModeContext(context.Background(), trx, mode)
+}
+
+// SetAGCModeContext is like SetAGCMode, but uses the given context.
+func (c *Client) SetAGCModeContext(ctx context.Context, trx int, mode AGCMode) error {
+	_, err := c.command(ctx, "agc_mode", trx, mode)
+	return err
+}
+
+// AGCMode reads the AGC mode of the given TRX. (since TCI 1.8, unverified)
+func (c *Client) AGCMode(trx int) (AGCMode, error) {
+	return c.AGCModeContext(context.Background(), trx)
+}
+
+// AGCModeContext is like AGCMode, but uses the given context.
+func (c *Client) AGCModeContext(ctx context.Context, trx int) (AGCMode, error) {
+	reply, err := c.request(ctx, "agc_mode", trx)
+	if err != nil {
+		return "", err
+	}
+	return reply.toAGCMode(1)
+}
+
+// SetAGCGain sets the AGC gain of the given TRX in dB. (since TCI 1.8, unverified)
+func (c *Client) SetAGCGain(trx int, dB int) error {
+	return c.SetAGCGainContext(context.Background(), trx, dB)
+}
+
+// SetAGCGainContext is like SetAGCGain, but uses the given context.
+func (c *Client) SetAGCGainContext(ctx context.Context, trx int, dB int) error {
+	_, err := c.command(ctx, "agc_gain", trx, dB)
+	return err
+}
+
+// AGCGain reads the AGC gain of the given TRX in dB. (since TCI 1.8, unverified)
+func (c *Client) AGCGain(trx int) (int, error) {
+	return c.AGCGainContext(context.Background(), trx)
+}
+
+// AGCGainContext is like AGCGain, but uses the given context.
+func (c *Client) AGCGainContext(ctx context.Context, trx int) (int, error) {
+	reply, err := c.request(ctx, "agc_gain", trx)
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(1)
+}
+
+// SetLock locks/unlocks the tuning of the given TRX. (since TCI 1.8, unverified)
+func (c *Client) SetLock(trx int, enabled bool) error {
+	return c.SetLockContext(context.Background(), trx, enabled)
+}
+
+// SetLockContext is like SetLock, but uses the given context.
+func (c *Client) SetLockContext(ctx context.Context, trx int, enabled bool) error {
+	_, err := c.command(ctx, "lock", trx, enabled)
+	return err
+}
+
+// Lock reads the tuning lock state of the given TRX. (since TCI 1.8, unverified)
+func (c *Client) Lock(trx int) (bool, error) {
+	return c.LockContext(context.Background(), trx)
+}
+
+// LockContext is like Lock, but uses the given context.
+func (c *Client) LockContext(ctx context.Context, trx int) (bool, error) {
+	reply, err := c.request(ctx, "lock", trx)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(1)
+}
+
+// SetVFOLock locks/unlocks the tuning of the given TRX's vfo. (since TCI 1.9, unverified)
+func (c *Client) SetVFOLock(trx int, vfo VFO, enabled bool) error {
+	return c.SetVFOLockContext(context.Background(), trx, vfo, enabled)
+}
+
+// SetVFOLockContext is like SetVFOLock, but uses the given context.
+func (c *Client) SetVFOLockContext(ctx context.Context, trx int, vfo VFO, enabled bool) error {
+	_, err := c.command(ctx, "vfo_lock", trx, vfo, enabled)
+	return err
+}
+
+// VFOLock reads the tuning lock state of the given TRX's vfo. (since TCI 1.9, unverified)
+func (c *Client) VFOLock(trx int, vfo VFO) (bool, error) {
+	return c.VFOLockContext(context.Background(), trx, vfo)
+}
+
+// VFOLockContext is like VFOLock, but uses the given context.
+func (c *Client) VFOLockContext(ctx context.Context, trx int, vfo VFO) (bool, error) {
+	reply, err := c.request(ctx, "vfo_lock", trx, vfo)
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(2)
+}
+
+// SetMonitorEnable enables/disables the monitoring of the transmitted signal. (since TCI 1.8, unverified)
+func (c *Client) SetMonitorEnable(enabled bool) error {
+	return c.SetMonitorEnableContext(context.Background(), enabled)
+}
+
+// SetMonitorEnableContext is like SetMonitorEnable, but uses the given context.
+func (c *Client) SetMonitorEnableContext(ctx context.Context, enabled bool) error {
+	_, err := c.command(ctx, "mon_enable", enabled)
+	return err
+}
+
+// MonitorEnable reads the monitor enable state. (since TCI 1.8, unverified)
+func (c *Client) MonitorEnable() (bool, error) {
+	return c.MonitorEnableContext(context.Background())
+}
+
+// MonitorEnableContext is like MonitorEnable, but uses the given context.
+func (c *Client) MonitorEnableContext(ctx context.Context) (bool, error) {
+	reply, err := c.request(ctx, "mon_enable")
+	if err != nil {
+		return false, err
+	}
+	return reply.ToBool(0)
+}
+
+// SetMonitorVolume sets the monitor volume in dB (range from -60dB to 0dB). (since TCI 1.8, unverified)
+func (c *Client) SetMonitorVolume(dB int) error {
+	return c.SetMonitorVolumeContext(context.Background(), dB)
+}
+
+// SetMonitorVolumeContext is like SetMonitorVolume, but uses the given context.
+func (c *Client) SetMonitorVolumeContext(ctx context.Context, dB int) error {
+	_, err := c.command(ctx, "mon_volume", dB)
+	return err
+}
+
+// MonitorVolume reads the monitor volume in dB (range from -60dB to 0dB). (since TCI 1.8, unverified)
+func (c *Client) MonitorVolume() (int, error) {
+	return c.MonitorVolumeContext(context.Background())
+}
+
+// MonitorVolumeContext is like MonitorVolume, but uses the given context.
+func (c *Client) MonitorVolumeContext(ctx context.Context) (int, error) {
+	reply, err := c.request(ctx, "mon_volume")
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(0)
+}
+
+// SetDIGLOffset sets the frequency offset in Hz that is used in DIGL mode. (since TCI 1.8, unverified)
+func (c *Client) SetDIGLOffset(offset int) error {
+	return c.SetDIGLOffsetContext(context.Background(), offset)
+}
+
+// SetDIGLOffsetContext is like SetDIGLOffset, but uses the given context.
+func (c *Client) SetDIGLOffsetContext(ctx context.Context, offset int) error {
+	_, err := c.command(ctx, "digl_offset", offset)
+	return err
+}
+
+// DIGLOffset reads the frequency offset in Hz that is used in DIGL mode. (since TCI 1.8, unverified)
+func (c *Client) DIGLOffset() (int, error) {
+	return c.DIGLOffsetContext(context.Background())
+}
+
+// DIGLOffsetContext is like DIGLOffset, but uses the given context.
+func (c *Client) DIGLOffsetContext(ctx context.Context) (int, error) {
+	reply, err := c.request(ctx, "digl_offset")
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(0)
+}
+
+// SetDIGUOffset sets the frequency offset in Hz that is used in DIGU mode. (since TCI 1.8, unverified)
+func (c *Client) SetDIGUOffset(offset int) error {
+	return c.SetDIGUOffsetContext(context.Background(), offset)
+}
+
+// SetDIGUOffsetContext is like SetDIGUOffset, but uses the given context.
+func (c *Client) SetDIGUOffsetContext(ctx context.Context, offset int) error {
+	_, err := c.command(ctx, "digu_offset", offset)
+	return err
+}
+
+// DIGUOffset reads the frequency offset in Hz that is used in DIGU mode. (since TCI 1.8, unverified)
+func (c *Client) DIGUOffset() (int, error) {
+	return c.DIGUOffsetContext(context.Background())
+}
+
+// DIGUOffsetContext is like DIGUOffset, but uses the given context.
+func (c *Client) DIGUOffsetContext(ctx context.Context) (int, error) {
+	reply, err := c.request(ctx, "digu_offset")
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(0)
+}
+
+// SetTXStreamAudioBuffering sets the length of the TX audio stream buffer in milliseconds (range from 50ms to 500ms). (since TCI 1.9, unverified)
+func (c *Client) SetTXStreamAudioBuffering(milliseconds int) error {
+	return c.SetTXStreamAudioBufferingContext(context.Background(), milliseconds)
+}
+
+// SetTXStreamAudioBufferingContext is like SetTXStreamAudioBuffering, but uses the given context.
+func (c *Client) SetTXStreamAudioBufferingContext(ctx context.Context, milliseconds int) error {
+	_, err := c.command(ctx, "tx_stream_audio_buffering", milliseconds)
+	return err
+}
+
+// TXStreamAudioBuffering reads the length of the TX audio stream buffer in milliseconds (range from 50ms to 500ms). (since TCI 1.9, unverified)
+func (c *Client) TXStreamAudioBuffering() (int, error) {
+	return c.TXStreamAudioBufferingContext(context.Background())
+}
+
+// TXStreamAudioBufferingContext is like TXStreamAudioBuffering, but uses the given context.
+func (c *Client) TXStreamAudioBufferingContext(ctx context.Context) (int, error) {
+	reply, err := c.request(ctx, "tx_stream_audio_buffering")
+	if err != nil {
+		return 0, err
+	}
+	return reply.ToInt(0)
+}
+
+// StopCW stops the current CW transmission.
+func (c *Client) StopCW() error {
+	return c.StopCWContext(context.Background())
+}
+
+// StopCWContext is like StopCW, but uses the given context.
+func (c *Client) StopCWContext(ctx context.Context) error {
+	_, err := c.command(ctx, "cw_macros_stop")
+	return err
+}
+
+// SetCWTerminal enables/disables the terminal mode for CW transmission. (since TCI 1.5)
+func (c *Client) SetCWTerminal(enabled bool) error {
+	return c.SetCWTerminalContext(context.Background(), enabled)
+}
+
+// SetCWTerminalContext is like SetCWTerminal, but uses the given context.
+func (c *Client) SetCWTerminalContext(ctx context.Context, enabled bool) error {
+	_, err := c.command(ctx, "cw_terminal", enabled)
+	return err
+}
+
+// CWMacrosSpeedInc increases the speed for CW macros by the given delta in WPM.
+func (c *Client) CWMacrosSpeedInc(delta int) error {
+	return c.CWMacrosSpeedIncContext(context.Background(), delta)
+}
+
+// CWMacrosSpeedIncContext is like CWMacrosSpeedInc, but uses the given context.
+func (c *Client) CWMacrosSpeedIncContext(ctx context.Context, delta int) error {
+	_, err := c.command(ctx, "cw_macros_speed_up", delta)
+	return err
+}
+
+// CWMacrosSpeedDec decreases the speed for CW macros by the given delta in WPM.
+func (c *Client) CWMacrosSpeedDec(delta int) error {
+	return c.CWMacrosSpeedDecContext(context.Background(), delta)
+}
+
+// CWMacrosSpeedDecContext is like CWMacrosSpeedDec, but uses the given context.
+func (c *Client) CWMacrosSpeedDecContext(ctx context.Context, delta int) error {
+	_, err := c.command(ctx, "cw_macros_speed_down", delta)
+	return err
+}
+
+// BringToFront brings main ExpertSDR window into the focus.
+func (c *Client) BringToFront() error {
+	return c.BringToFrontContext(context.Background())
+}
+
+// BringToFrontContext is like BringToFront, but uses the given context.
+func (c *Client) BringToFrontContext(ctx context.Context) error {
+	_, err := c.command(ctx, "set_in_focus")
+	return err
+}
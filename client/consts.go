@@ -1,6 +1,9 @@
 package client
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+)
 
 // VFO represents a VFO in TCI. In the TCI documentation the VFOs area also named "channel".
 type VFO int
@@ -31,6 +34,16 @@ const (
 	ModeDRM  = Mode("drm")
 )
 
+// AGCMode represents the mode of a TRX's automatic gain control.
+type AGCMode string
+
+// All AGC modes available in TCI.
+const (
+	AGCModeOff    = AGCMode("off")
+	AGCModeFast   = AGCMode("fast")
+	AGCModeNormal = AGCMode("normal")
+)
+
 // SignalSource represents the source of the TX audio signal.
 type SignalSource string
 
@@ -62,6 +75,63 @@ const (
 	AudioSampleRate48k = AudioSampleRate(48000)
 )
 
+// SampleType represents the encoding of the samples in binary messages.
+type SampleType int
+
+// All available sample types.
+const (
+	SampleTypeInt16   = SampleType(0)
+	SampleTypeInt24   = SampleType(1)
+	SampleTypeInt32   = SampleType(2)
+	SampleTypeFloat32 = SampleType(3)
+
+	// sampleTypeLegacyFloat32 is the format value that NewTXAudioMessage always used for 32-bit float samples.
+	sampleTypeLegacyFloat32 = SampleType(4)
+)
+
+var sampleTypeNames = map[SampleType]string{
+	SampleTypeInt16:   "int16",
+	SampleTypeInt24:   "int24",
+	SampleTypeInt32:   "int32",
+	SampleTypeFloat32: "float32",
+}
+
+// ParseSampleType returns the sample type with the given name as used in TCI messages.
+func ParseSampleType(s string) (SampleType, error) {
+	s = strings.ToLower(s)
+	for sampleType, name := range sampleTypeNames {
+		if name == s {
+			return sampleType, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown sample type: %s", s)
+}
+
+func (t SampleType) String() string {
+	if t == sampleTypeLegacyFloat32 {
+		return sampleTypeNames[SampleTypeFloat32]
+	}
+	name, ok := sampleTypeNames[t]
+	if !ok {
+		return fmt.Sprintf("SampleType(%d)", int(t))
+	}
+	return name
+}
+
+// Size returns the number of bytes that are used to encode one sample.
+func (t SampleType) Size() (int, error) {
+	switch t {
+	case SampleTypeInt16:
+		return 2, nil
+	case SampleTypeInt24:
+		return 3, nil
+	case SampleTypeInt32, SampleTypeFloat32, sampleTypeLegacyFloat32:
+		return 4, nil
+	default:
+		return 0, fmt.Errorf("unknown sample type: %d", int(t))
+	}
+}
+
 // NewARGB returns a ARGB with the given alpha, red, green, and blue values.
 func NewARGB(a, r, g, b byte) ARGB {
 	return ARGB(uint32(a)<<24 | uint32(r)<<16 | uint32(g)<<8 | uint32(b))
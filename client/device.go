@@ -1,7 +1,10 @@
 package client
 
+import "sync"
+
 // DeviceInfo contains the basic information about the SunSDR device which are transmitted when the TCI connection is established.
-// This information cannot be requested through TCI, so it is automatically collected by the client and provided through this type.
+// This information cannot be requested through TCI, so it is automatically collected by the client and provided through this type,
+// see Client.DeviceInfo.
 type DeviceInfo struct {
 	DeviceName      string
 	ProtocolName    string
@@ -58,3 +61,86 @@ func (d *DeviceInfo) SetRXOnly(value bool) {
 func (d *DeviceInfo) SetModes(modes []Mode) {
 	d.Modes = modes
 }
+
+// deviceInfoCollector collects the DeviceInfo during the handshake of each connection and publishes it when the
+// READY message is received. It is safe for concurrent use.
+type deviceInfoCollector struct {
+	lock      sync.RWMutex
+	collected DeviceInfo
+	published DeviceInfo
+}
+
+// reset starts collecting the DeviceInfo of a new connection. The published DeviceInfo is kept until the next
+// READY message, unless a different device is announced.
+func (d *deviceInfoCollector) reset() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.collected = DeviceInfo{}
+}
+
+// publish makes the collected DeviceInfo available through snapshot.
+func (d *deviceInfoCollector) publish() {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.published = d.collected
+	d.published.Modes = append([]Mode(nil), d.collected.Modes...)
+}
+
+// snapshot returns the published DeviceInfo. The Modes slice is shared and must not be modified.
+func (d *deviceInfoCollector) snapshot() DeviceInfo {
+	d.lock.RLock()
+	defer d.lock.RUnlock()
+	return d.published
+}
+
+func (d *deviceInfoCollector) update(f func(*DeviceInfo)) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	f(&d.collected)
+}
+
+// SetDeviceName handles the DEVICE message. If a different device is announced, the published DeviceInfo is stale
+// and therefore removed.
+func (d *deviceInfoCollector) SetDeviceName(name string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.collected.SetDeviceName(name)
+	if d.published.DeviceName != name {
+		d.published = DeviceInfo{}
+	}
+}
+
+// SetProtocol handles the PROTOCOL message.
+func (d *deviceInfoCollector) SetProtocol(name string, version string) {
+	d.update(func(info *DeviceInfo) { info.SetProtocol(name, version) })
+}
+
+// SetVFOLimits handles the VFO_LIMITS message.
+func (d *deviceInfoCollector) SetVFOLimits(min int, max int) {
+	d.update(func(info *DeviceInfo) { info.SetVFOLimits(min, max) })
+}
+
+// SetIFLimits handles the IF_LIMITS message.
+func (d *deviceInfoCollector) SetIFLimits(min int, max int) {
+	d.update(func(info *DeviceInfo) { info.SetIFLimits(min, max) })
+}
+
+// SetTRXCount handles the TRX_COUNT message.
+func (d *deviceInfoCollector) SetTRXCount(count int) {
+	d.update(func(info *DeviceInfo) { info.SetTRXCount(count) })
+}
+
+// SetChannelCount handles the CHANNEL_COUNT message.
+func (d *deviceInfoCollector) SetChannelCount(count int) {
+	d.update(func(info *DeviceInfo) { info.SetChannelCount(count) })
+}
+
+// SetRXOnly handles the RECEIVE_ONLY message.
+func (d *deviceInfoCollector) SetRXOnly(value bool) {
+	d.update(func(info *DeviceInfo) { info.SetRXOnly(value) })
+}
+
+// SetModes handles the MODULATIONS_LIST message.
+func (d *deviceInfoCollector) SetModes(modes []Mode) {
+	d.update(func(info *DeviceInfo) { info.SetModes(modes) })
+}
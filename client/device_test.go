@@ -0,0 +1,127 @@
+package client_test
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ftl/tci/client"
+	"github.com/ftl/tci/client/clienttest"
+)
+
+func TestDeviceInfo_ReturnsACopy(t *testing.T) {
+	_, c := openTestClient(t)
+
+	info := c.DeviceInfo()
+	info.Modes[0] = client.ModeSPEC
+	info.TRXCount = 8
+
+	assert.NotEqual(t, client.ModeSPEC, c.DeviceInfo().Modes[0])
+	assert.Equal(t, 2, c.DeviceInfo().TRXCount)
+}
+
+func TestDeviceInfo_ConcurrentAccess(t *testing.T) {
+	server, c := openTestClient(t)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 50; i++ {
+			assert.NoError(t, server.Send(client.NewCommandMessage("vfo_limits", 10000, 30000000+i)))
+			assert.NoError(t, server.Send(client.NewCommandMessage("modulations_list", "am", "usb", "cw")))
+			assert.NoError(t, server.Send(client.NewCommandMessage("ready")))
+		}
+	}()
+	for i := 0; i < 500; i++ {
+		info := c.DeviceInfo()
+		assert.Equal(t, "SunSDR2PRO", info.DeviceName)
+	}
+	wg.Wait()
+}
+
+// handshakeWithout returns the default handshake with the given device, but without the given messages.
+func handshakeWithout(device string, names ...string) []client.Message {
+	omitted := make(map[string]bool, len(names))
+	for _, name := range names {
+		omitted[name] = true
+	}
+	result := []client.Message{}
+	for _, msg := range clienttest.DefaultHandshake() {
+		switch {
+		case msg.Name() == "device":
+			result = append(result, client.NewCommandMessage("device", device))
+		case !omitted[msg.Name()]:
+			result = append(result, msg)
+		}
+	}
+	return result
+}
+
+// keepOpenRedirected opens a client that always connects to the address stored in the given target.
+func keepOpenRedirected(t *testing.T, target *atomic.Value, options ...client.Option) *client.Client {
+	t.Helper()
+	dialer := *websocket.DefaultDialer
+	dialer.NetDialContext = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		var d net.Dialer
+		return d.DialContext(ctx, network, target.Load().(string))
+	}
+	options = append([]client.Option{
+		client.WithDialer(&dialer),
+		client.WithReconnectPolicy(client.ReconnectPolicy{InitialDelay: time.Millisecond, Multiplier: 1}),
+	}, options...)
+	c, err := client.KeepOpenURL("ws://localhost:40001", options...)
+	require.NoError(t, err)
+	t.Cleanup(c.Disconnect)
+	return c
+}
+
+func TestDeviceInfo_ResetWhenReconnectingToADifferentDevice(t *testing.T) {
+	first := clienttest.NewServer()
+	defer first.Close()
+	second := clienttest.NewServer(handshakeWithout("SunSDR2DX", "modulations_list", "trx_count")...)
+	defer second.Close()
+
+	var target atomic.Value
+	target.Store(first.Addr().String())
+	c := keepOpenRedirected(t, &target)
+	require.Eventually(t, func() bool { return c.DeviceInfo().DeviceName == "SunSDR2PRO" }, time.Second, time.Millisecond)
+	require.NotEmpty(t, c.DeviceInfo().Modes)
+
+	target.Store(second.Addr().String())
+	first.DisconnectClients()
+
+	require.Eventually(t, func() bool { return c.DeviceInfo().DeviceName == "SunSDR2DX" }, time.Second, time.Millisecond)
+	info := c.DeviceInfo()
+	assert.Empty(t, info.Modes)
+	assert.Zero(t, info.TRXCount)
+	assert.Equal(t, 30000000, info.MaxVFOFrequency)
+}
+
+func TestProtocolVersion_ResetWhenReconnecting(t *testing.T) {
+	first := clienttest.NewServer()
+	defer first.Close()
+	second := clienttest.NewServer(handshakeWithout("SunSDR2DX", "protocol")...)
+	defer second.Close()
+
+	var target atomic.Value
+	target.Store(first.Addr().String())
+	c := keepOpenRedirected(t, &target)
+	require.Eventually(t, func() bool { return c.DeviceInfo().DeviceName == "SunSDR2PRO" }, time.Second, time.Millisecond)
+	require.Equal(t, client.Version(1.8), c.ProtocolVersion())
+
+	target.Store(second.Addr().String())
+	first.DisconnectClients()
+
+	require.Eventually(t, func() bool { return c.DeviceInfo().DeviceName == "SunSDR2DX" }, time.Second, time.Millisecond)
+	assert.Equal(t, client.Version(1.4), c.ProtocolVersion())
+	assert.False(t, c.Supports("rx_nb_enable"))
+	assert.ErrorIs(t, c.SetRXNBEnable(0, true), client.ErrUnsupported)
+}
@@ -0,0 +1,275 @@
+package client
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// textBacklogWarning is the number of queued text messages of a single listener that is reported as warning.
+// Text messages are never dropped, the queue of a slow listener grows until the listener catches up.
+const textBacklogWarning = 1024
+
+// textDispatcher notifies a single listener about text messages in its own goroutine, so a slow listener can neither
+// stall the other listeners nor the replies to the commands.
+type textDispatcher struct {
+	notifier *notifier
+	listener interface{}
+	wakeup   chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+
+	lock    sync.Mutex
+	queue   []textItem
+	warned  bool
+	pending int
+}
+
+// textItem is either a message or a marker that is closed when all previous messages were handled.
+type textItem struct {
+	msg     Message
+	flushed chan struct{}
+}
+
+func newTextDispatcher(n *notifier, listener interface{}) *textDispatcher {
+	result := &textDispatcher{
+		notifier: n,
+		listener: listener,
+		wakeup:   make(chan struct{}, 1),
+		done:     make(chan struct{}),
+	}
+	go result.dispatchLoop()
+	return result
+}
+
+func (d *textDispatcher) dispatchLoop() {
+	listeners := []interface{}{d.listener}
+	for {
+		select {
+		case <-d.notifier.closed:
+			return
+		case <-d.done:
+			return
+		case <-d.wakeup:
+		}
+
+		d.lock.Lock()
+		items := d.queue
+		d.queue = nil
+		d.lock.Unlock()
+
+		for _, item := range items {
+			select {
+			case <-d.done:
+				return
+			default:
+			}
+			if item.flushed != nil {
+				close(item.flushed)
+				continue
+			}
+			// errors are already logged by the read loop
+			_, _ = d.notifier.handleIncomingMessage(item.msg, listeners)
+		}
+		d.lock.Lock()
+		d.pending -= len(items)
+		d.lock.Unlock()
+	}
+}
+
+// dispatch queues the given message for the listener. It never blocks.
+func (d *textDispatcher) dispatch(msg Message) {
+	d.enqueue(textItem{msg: msg})
+}
+
+// flush returns a channel that is closed when the listener was notified about all messages that were queued before.
+func (d *textDispatcher) flush() <-chan struct{} {
+	result := make(chan struct{})
+	d.enqueue(textItem{flushed: result})
+	return result
+}
+
+func (d *textDispatcher) enqueue(item textItem) {
+	select {
+	case <-d.done:
+		return
+	default:
+	}
+	d.lock.Lock()
+	d.queue = append(d.queue, item)
+	d.pending++
+	warn := d.pending >= textBacklogWarning && !d.warned
+	if warn {
+		d.warned = true
+	} else if d.pending < textBacklogWarning {
+		d.warned = false
+	}
+	pending := d.pending
+	d.lock.Unlock()
+
+	if warn {
+		d.notifier.logger.Warn("listener cannot keep up with the incoming messages", "type", fmt.Sprintf("%T", d.listener), "queued", pending)
+	}
+	select {
+	case d.wakeup <- struct{}{}:
+	default:
+	}
+}
+
+func (d *textDispatcher) stop() {
+	d.stopOnce.Do(func() {
+		close(d.done)
+	})
+}
+
+// streamQueueSize is the number of binary messages that are buffered for each streaming listener.
+// If a listener cannot keep up, further IQ and RX audio messages are dropped for this listener. TX chrono messages are
+// never dropped, see streamDispatcher.dispatchChrono.
+const streamQueueSize = 32
+
+// StreamStats contains the metrics of the binary message dispatch to all streaming listeners.
+type StreamStats struct {
+	// Delivered is the number of binary messages that were queued for a listener.
+	Delivered uint64
+	// Dropped is the number of binary messages that were dropped because a listener's queue was full.
+	Dropped uint64
+}
+
+type streamCounters struct {
+	delivered atomic.Uint64
+	dropped   atomic.Uint64
+}
+
+// StreamStats returns the current metrics of the binary message dispatch.
+func (n *notifier) StreamStats() StreamStats {
+	return StreamStats{
+		Delivered: n.streamStats.delivered.Load(),
+		Dropped:   n.streamStats.dropped.Load(),
+	}
+}
+
+// streamDispatcher notifies a single streaming listener about binary messages in its own goroutine,
+// so a slow listener can neither stall the other listeners nor the websocket connection.
+type streamDispatcher struct {
+	listener interface{}
+	messages chan BinaryMessage
+	chronos  chan BinaryMessage
+	closed   <-chan struct{}
+	done     chan struct{}
+	stopOnce sync.Once
+	counters *streamCounters
+	// dispatching is held by dispatch while it queues a message, stop waits for it before draining the queues.
+	dispatching sync.RWMutex
+}
+
+func newStreamDispatcher(listener interface{}, closed <-chan struct{}, counters *streamCounters) *streamDispatcher {
+	result := &streamDispatcher{
+		listener: listener,
+		messages: make(chan BinaryMessage, streamQueueSize),
+		closed:   closed,
+		done:     make(chan struct{}),
+		counters: counters,
+	}
+	if isChronoListener(listener) {
+		result.chronos = make(chan BinaryMessage, streamQueueSize)
+	}
+	go result.dispatchLoop()
+	return result
+}
+
+// isChronoListener indicates if the given listener is interested in TX chrono messages.
+func isChronoListener(l interface{}) bool {
+	switch l.(type) {
+	case BinaryMessageListener, TXChronoListener:
+		return true
+	default:
+		return false
+	}
+}
+
+func (d *streamDispatcher) dispatchLoop() {
+	for {
+		select {
+		case <-d.closed:
+			d.stop()
+			return
+		case <-d.done:
+			return
+		case msg := <-d.chronos:
+			handleIncomingBinaryMessage(d.listener, msg)
+			msg.release()
+		case msg := <-d.messages:
+			handleIncomingBinaryMessage(d.listener, msg)
+			msg.release()
+		}
+	}
+}
+
+// dispatch queues the given message for the listener. The queued message holds its own reference to a pooled
+// sample buffer, which is released when the listener returns.
+func (d *streamDispatcher) dispatch(msg BinaryMessage) {
+	d.dispatching.RLock()
+	defer d.dispatching.RUnlock()
+	if d.isStopped() {
+		return
+	}
+	if msg.Type == TXChronoMessage {
+		d.dispatchChrono(msg)
+		return
+	}
+	msg.retain()
+	select {
+	case d.messages <- msg:
+		d.counters.delivered.Add(1)
+	default:
+		msg.release()
+		d.counters.dropped.Add(1)
+	}
+}
+
+// dispatchChrono queues the given TX chrono message for the listener. TX chrono messages are never dropped, since each
+// lost message leaves a gap in the TX audio. If the listener's chrono queue is full, dispatchChrono blocks until
+// the listener catches up. Listeners that are not interested in TX chrono messages do not get them queued at all.
+func (d *streamDispatcher) dispatchChrono(msg BinaryMessage) {
+	if d.chronos == nil {
+		return
+	}
+	msg.retain()
+	select {
+	case d.chronos <- msg:
+		d.counters.delivered.Add(1)
+	case <-d.done:
+		msg.release()
+	case <-d.closed:
+		msg.release()
+	}
+}
+
+func (d *streamDispatcher) isStopped() bool {
+	select {
+	case <-d.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// stop stops the dispatch loop and releases all queued messages, so their pooled sample buffers return to the pool.
+func (d *streamDispatcher) stop() {
+	d.stopOnce.Do(func() {
+		close(d.done)
+		// wait until no message is queued anymore, dispatch does not queue messages after done was closed
+		d.dispatching.Lock()
+		defer d.dispatching.Unlock()
+		for {
+			select {
+			case msg := <-d.messages:
+				msg.release()
+			case msg := <-d.chronos:
+				msg.release()
+			default:
+				return
+			}
+		}
+	})
+}
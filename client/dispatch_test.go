@@ -0,0 +1,258 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type blockingIQListener struct {
+	release chan struct{}
+}
+
+func (l *blockingIQListener) IQData(int, IQSampleRate, []float32) {
+	<-l.release
+}
+
+func TestStreamDispatch_SlowListenerDoesNotBlock(t *testing.T) {
+	n := newNotifier(nil, make(chan struct{}))
+	slow := &blockingIQListener{release: make(chan struct{})}
+	defer close(slow.release)
+	n.Notify(slow)
+	received := make(chan int, streamQueueSize*2)
+	n.OnIQData(func(trx int, _ IQSampleRate, _ []float32) {
+		received <- trx
+	})
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < streamQueueSize*2; i++ {
+			n.binaryMessage(BinaryMessage{Type: IQStreamMessage, TRX: i})
+			time.Sleep(100 * time.Microsecond)
+		}
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("binary dispatch is blocked by the slow listener")
+	}
+	assert.Eventually(t, func() bool { return len(received) == streamQueueSize*2 }, time.Second, time.Millisecond)
+	stats := n.StreamStats()
+	assert.NotZero(t, stats.Dropped)
+	assert.Equal(t, uint64(streamQueueSize*4), stats.Delivered+stats.Dropped)
+}
+
+// blockingStreamListener blocks on IQ data until it is released and counts the TX chrono messages.
+type blockingStreamListener struct {
+	release chan struct{}
+	chronos chan uint32
+}
+
+func (l *blockingStreamListener) IQData(int, IQSampleRate, []float32) {
+	<-l.release
+}
+
+func (l *blockingStreamListener) TXChrono(_ int, _ AudioSampleRate, requestedSampleCount uint32) {
+	l.chronos <- requestedSampleCount
+}
+
+func TestStreamDispatch_NeverDropsTXChrono(t *testing.T) {
+	n := newNotifier(nil, make(chan struct{}))
+	slow := &blockingStreamListener{release: make(chan struct{}), chronos: make(chan uint32, streamQueueSize*4)}
+	n.Notify(slow)
+	slowIQ := &blockingIQListener{release: make(chan struct{})}
+	defer close(slowIQ.release)
+	n.Notify(slowIQ)
+
+	for i := 0; i < streamQueueSize*2; i++ {
+		n.binaryMessage(BinaryMessage{Type: IQStreamMessage})
+	}
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < streamQueueSize*3; i++ {
+			n.binaryMessage(BinaryMessage{Type: TXChronoMessage, DataLength: uint32(i)})
+		}
+		close(done)
+	}()
+	time.Sleep(10 * time.Millisecond)
+	close(slow.release)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("TX chrono dispatch is blocked")
+	}
+	for i := 0; i < streamQueueSize*3; i++ {
+		select {
+		case count := <-slow.chronos:
+			assert.Equal(t, uint32(i), count)
+		case <-time.After(time.Second):
+			t.Fatalf("TX chrono message %d was lost", i)
+		}
+	}
+	assert.NotZero(t, n.StreamStats().Dropped)
+}
+
+func TestStreamDispatch_ReleasesPooledSamples(t *testing.T) {
+	n := newNotifier(nil, make(chan struct{}))
+	slow := &blockingIQListener{release: make(chan struct{})}
+	n.Notify(slow)
+	received := make(chan []float32, streamQueueSize*2)
+	n.OnIQData(func(_ int, _ IQSampleRate, data []float32) {
+		received <- append([]float32(nil), data...)
+	})
+	pool := new(samplePool)
+
+	var buffers []*pooledSamples
+	for i := 0; i < streamQueueSize*2; i++ {
+		msg, err := pool.parse(iqFrame(SampleTypeFloat32, 16))
+		assert.NoError(t, err)
+		buffers = append(buffers, msg.samples)
+		n.binaryMessage(msg)
+		msg.release()
+		time.Sleep(100 * time.Microsecond)
+	}
+	close(slow.release)
+
+	assert.Eventually(t, func() bool { return len(received) == streamQueueSize*2 }, time.Second, time.Millisecond)
+	assert.Len(t, <-received, 16)
+	assert.Eventually(t, func() bool {
+		for _, buffer := range buffers {
+			if buffer.refs.Load() != 0 {
+				return false
+			}
+		}
+		return true
+	}, time.Second, time.Millisecond)
+	assert.NotZero(t, n.StreamStats().Dropped)
+}
+
+func TestStreamDispatch_StopReleasesQueuedSamples(t *testing.T) {
+	n := newNotifier(nil, make(chan struct{}))
+	slow := &blockingIQListener{release: make(chan struct{})}
+	unsubscribe := n.Subscribe(slow)
+	pool := new(samplePool)
+
+	var buffers []*pooledSamples
+	for i := 0; i < streamQueueSize*2; i++ {
+		msg, err := pool.parse(iqFrame(SampleTypeFloat32, 16))
+		assert.NoError(t, err)
+		buffers = append(buffers, msg.samples)
+		n.binaryMessage(msg)
+		msg.release()
+	}
+	unsubscribe()
+	close(slow.release)
+
+	assert.Eventually(t, func() bool {
+		for _, buffer := range buffers {
+			if buffer.refs.Load() != 0 {
+				return false
+			}
+		}
+		return true
+	}, time.Second, time.Millisecond)
+}
+
+type blockingMessageListener struct {
+	release  chan struct{}
+	received chan Message
+}
+
+func (l *blockingMessageListener) Message(msg Message) {
+	<-l.release
+	l.received <- msg
+}
+
+func TestTextDispatch_SlowListenerDoesNotBlock(t *testing.T) {
+	const count = 1000
+	n := newNotifier(nil, make(chan struct{}))
+	slow := &blockingMessageListener{release: make(chan struct{}), received: make(chan Message, count)}
+	n.Notify(slow)
+	received := make(chan Message, count)
+	n.OnMessage(func(msg Message) {
+		received <- msg
+	})
+
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < count; i++ {
+			n.textMessage(NewCommandMessage("vfo", 0, 0, i))
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("text dispatch is blocked by the slow listener")
+	}
+	assert.Eventually(t, func() bool { return len(received) == count }, time.Second, time.Millisecond)
+
+	close(slow.release)
+	for i := 0; i < count; i++ {
+		select {
+		case msg := <-slow.received:
+			frequency, err := msg.ToInt(2)
+			assert.NoError(t, err)
+			assert.Equal(t, i, frequency)
+		case <-time.After(time.Second):
+			t.Fatalf("message %d was lost", i)
+		}
+	}
+}
+
+func TestSamplePool_ReusesReleasedBuffers(t *testing.T) {
+	pool := new(samplePool)
+	frame := iqFrame(SampleTypeFloat32, 16)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		msg, err := pool.parse(frame)
+		if err != nil {
+			t.Fatal(err)
+		}
+		msg.release()
+	})
+
+	assert.Less(t, allocs, float64(1))
+}
+
+func BenchmarkStreamDispatch(b *testing.B) {
+	frame := iqFrame(SampleTypeFloat32, 2048)
+	for _, pooled := range []bool{false, true} {
+		name := "unpooled"
+		if pooled {
+			name = "pooled"
+		}
+		b.Run(name, func(b *testing.B) {
+			closed := make(chan struct{})
+			defer close(closed)
+			n := newNotifier(nil, closed)
+			received := make(chan struct{}, 1)
+			n.OnIQData(func(int, IQSampleRate, []float32) {
+				received <- struct{}{}
+			})
+			pool := new(samplePool)
+
+			b.ReportAllocs()
+			b.SetBytes(int64(len(frame)))
+			for i := 0; i < b.N; i++ {
+				var msg BinaryMessage
+				var err error
+				if pooled {
+					msg, err = pool.parse(frame)
+				} else {
+					msg, err = ParseBinaryMessage(frame)
+				}
+				if err != nil {
+					b.Fatal(err)
+				}
+				n.binaryMessage(msg)
+				msg.release()
+				<-received
+			}
+		})
+	}
+}
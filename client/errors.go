@@ -0,0 +1,129 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrDisconnected indicates that the TCI connection was lost after a command was sent and before its reply was received.
+var ErrDisconnected = errors.New("disconnected while waiting for the reply")
+
+// ErrNoEcho indicates that the TCI server did not echo a command within the timeout, which usually means that
+// the server ignored the command. It is only returned if the strict command mode is enabled with WithStrictCommands,
+// otherwise commands without echo are considered successful.
+var ErrNoEcho = errors.New("no echo")
+
+// ErrUnsupported indicates that a command is not supported by the negotiated TCI protocol version.
+// Errors of type *UnsupportedCommandError wrap ErrUnsupported.
+var ErrUnsupported = errors.New("unsupported command")
+
+// ErrArgumentOutOfRange indicates that an argument of a command is outside of its valid range.
+// Errors of type *ArgumentError wrap ErrArgumentOutOfRange.
+var ErrArgumentOutOfRange = errors.New("argument out of range")
+
+// ErrInvalidArgument indicates that an argument of a command cannot be represented in the TCI protocol.
+// Errors of type *InvalidArgumentError wrap ErrInvalidArgument.
+var ErrInvalidArgument = errors.New("invalid argument")
+
+// ErrReplyMismatch indicates that the TCI server replied to a command only with different arguments until the
+// timeout, e.g. because it clamped a value. Errors of type *ReplyMismatchError wrap ErrReplyMismatch.
+var ErrReplyMismatch = errors.New("reply mismatch")
+
+// ErrNotSupportedByDevice indicates that the connected device does not support an argument of a command, e.g. a mode
+// that is not in its modulations list, or transmitting with a receive-only device.
+// Errors of type *DeviceError wrap ErrNotSupportedByDevice.
+var ErrNotSupportedByDevice = errors.New("not supported by the device")
+
+// ErrUnknownSpot indicates that a spot was not placed through the SpotManager.
+var ErrUnknownSpot = errors.New("unknown spot")
+
+// UnsupportedCommandError is returned if a command requires a newer TCI protocol version than the one negotiated with the server.
+type UnsupportedCommandError struct {
+	Command         string
+	ProtocolVersion Version
+	RequiredVersion Version
+}
+
+func (e *UnsupportedCommandError) Error() string {
+	return fmt.Sprintf("%s requires at least TCI %v, the server uses TCI %v", e.Command, e.RequiredVersion, e.ProtocolVersion)
+}
+
+func (e *UnsupportedCommandError) Unwrap() error {
+	return ErrUnsupported
+}
+
+// ArgumentError is returned if an argument of a command is outside of its valid range.
+type ArgumentError struct {
+	Command  string
+	Argument string
+	Value    int
+	Min      int
+	Max      int
+}
+
+func (e *ArgumentError) Error() string {
+	return fmt.Sprintf("%s: %s %d is out of range [%d, %d]", e.Command, e.Argument, e.Value, e.Min, e.Max)
+}
+
+func (e *ArgumentError) Unwrap() error {
+	return ErrArgumentOutOfRange
+}
+
+// InvalidArgumentError is returned if an argument of a command cannot be represented in the TCI protocol.
+type InvalidArgumentError struct {
+	Command string
+	// Index is the index of the invalid argument, or -1 if the command name is invalid.
+	Index  int
+	Value  interface{}
+	Reason string
+}
+
+func (e *InvalidArgumentError) Error() string {
+	if e.Index < 0 {
+		return fmt.Sprintf("invalid command name %q: %s", e.Value, e.Reason)
+	}
+	return fmt.Sprintf("%s: invalid argument %d %q: %s", e.Command, e.Index, fmt.Sprint(e.Value), e.Reason)
+}
+
+func (e *InvalidArgumentError) Unwrap() error {
+	return ErrInvalidArgument
+}
+
+// DeviceError is returned if the connected device does not support an argument of a command.
+// It is only returned if the validation against the device limits is enabled with WithDeviceLimits.
+type DeviceError struct {
+	Command  string
+	Argument string
+	Value    interface{}
+	Reason   string
+}
+
+func (e *DeviceError) Error() string {
+	return fmt.Sprintf("%s: %s %v %s", e.Command, e.Argument, e.Value, e.Reason)
+}
+
+func (e *DeviceError) Unwrap() error {
+	return ErrNotSupportedByDevice
+}
+
+// ReplyMismatchError is returned if the TCI server replied to a command only with different arguments until the timeout.
+// Received is the latest of these replies.
+type ReplyMismatchError struct {
+	Sent     Message
+	Received Message
+}
+
+func (e *ReplyMismatchError) Error() string {
+	return fmt.Sprintf("reply mismatch: sent %s, received %s", e.Sent, e.Received)
+}
+
+func (e *ReplyMismatchError) Unwrap() error {
+	return ErrReplyMismatch
+}
+
+func checkRange(command string, argument string, value int, min int, max int) error {
+	if value < min || value > max {
+		return &ArgumentError{Command: command, Argument: argument, Value: value, Min: min, Max: max}
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package client_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ftl/tci/client"
+	"github.com/ftl/tci/client/clienttest"
+)
+
+func waitForReceived(t *testing.T, server *clienttest.Server, name string) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		for _, msg := range server.Received() {
+			if msg.Name() == name {
+				return true
+			}
+		}
+		return false
+	}, time.Second, time.Millisecond)
+}
+
+func TestUnsupportedCommand(t *testing.T) {
+	handshake := clienttest.DefaultHandshake()
+	handshake[0] = client.NewCommandMessage("protocol", "ExpertSDR2", "1.4")
+	server := clienttest.NewServer(handshake...)
+	defer server.Close()
+	c, err := client.OpenURL(server.URL())
+	require.NoError(t, err)
+	defer c.Disconnect()
+
+	err = c.SetTRXDrive(1, 50)
+	assert.ErrorIs(t, err, client.ErrUnsupported)
+	var unsupported *client.UnsupportedCommandError
+	require.True(t, errors.As(err, &unsupported))
+	assert.Equal(t, client.Version(1.4), unsupported.ProtocolVersion)
+	assert.Equal(t, client.Version(1.5), unsupported.RequiredVersion)
+	assert.Equal(t, "SetTRXDrive requires at least TCI 1.5, the server uses TCI 1.4", err.Error())
+}
+
+func TestArgumentOutOfRange(t *testing.T) {
+	server, c := openTestClient(t)
+
+	err := c.SetVolume(10)
+	assert.ErrorIs(t, err, client.ErrArgumentOutOfRange)
+	var argumentErr *client.ArgumentError
+	require.True(t, errors.As(err, &argumentErr))
+	assert.Equal(t, 10, argumentErr.Value)
+	assert.Empty(t, server.Received())
+}
+
+func TestReplyMismatch(t *testing.T) {
+	server, c := openTestClient(t)
+	server.Silence("volume")
+	c.SetTimeout(100 * time.Millisecond)
+
+	go func() {
+		waitForReceived(t, server, "volume")
+		server.Send(client.NewCommandMessage("volume", -20))
+	}()
+	err := c.SetVolume(-10)
+
+	assert.ErrorIs(t, err, client.ErrReplyMismatch)
+	var mismatch *client.ReplyMismatchError
+	require.True(t, errors.As(err, &mismatch))
+	assert.Equal(t, "volume:-20;", mismatch.Received.String())
+}
+
+func TestReplyMismatch_UnsolicitedUpdateBeforeEcho(t *testing.T) {
+	server, c := openTestClient(t)
+	server.Silence("volume")
+	c.SetTimeout(time.Second)
+
+	go func() {
+		waitForReceived(t, server, "volume")
+		server.Send(client.NewCommandMessage("volume", -20))
+		server.Send(client.NewCommandMessage("volume", -10))
+	}()
+	err := c.SetVolume(-10)
+
+	assert.NoError(t, err)
+}
+
+func TestDisconnectedWhileWaitingForReply(t *testing.T) {
+	server, c := openTestClient(t)
+	server.Silence("dds")
+	c.SetTimeout(time.Second)
+
+	go func() {
+		waitForReceived(t, server, "dds")
+		server.DisconnectClients()
+	}()
+	_, err := c.DDS(0)
+
+	assert.ErrorIs(t, err, client.ErrDisconnected)
+}
+
+func TestStrictCommands(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	server.Silence("volume")
+	c, err := client.OpenURL(server.URL(), client.WithStrictCommands(true))
+	require.NoError(t, err)
+	defer c.Disconnect()
+
+	assert.ErrorIs(t, c.SetVolume(-10), client.ErrNoEcho)
+	assert.NoError(t, c.SetMute(true))
+}
@@ -0,0 +1,360 @@
+package client
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// Event is a typed representation of a message that was received from the TCI server.
+type Event interface {
+	EventName() string
+}
+
+// trxEvent is implemented by all events that are related to a certain TRX.
+type trxEvent interface {
+	eventTRX() int
+}
+
+// OverflowPolicy defines how an EventStream behaves when its buffer is full.
+type OverflowPolicy int
+
+// All available overflow policies.
+const (
+	// DropNewest discards the new event.
+	DropNewest OverflowPolicy = iota
+	// DropOldest discards the oldest buffered event to make room for the new event.
+	DropOldest
+	// Block waits until there is room in the buffer. The following messages are queued for this stream meanwhile.
+	Block
+)
+
+// DefaultEventBufferSize is the buffer size of an EventStream if no size is configured.
+const DefaultEventBufferSize = 64
+
+// EventStreamConfig contains the configuration of an EventStream.
+type EventStreamConfig struct {
+	// BufferSize is the number of events that are buffered, DefaultEventBufferSize if zero.
+	BufferSize int
+	// Overflow defines what happens when the buffer is full.
+	Overflow OverflowPolicy
+	// Names selects the events by their message names. If empty, all events are selected.
+	Names []string
+	// TRXs selects the events by their TRX. If empty, the events of all TRXs are selected. Events that are not related to a TRX are always selected.
+	TRXs []int
+}
+
+// EventStream delivers typed events through the channel C.
+type EventStream struct {
+	// C delivers the events. It is closed when the stream is closed.
+	C <-chan Event
+
+	events      chan Event
+	done        chan struct{}
+	closeOnce   sync.Once
+	lock        sync.Mutex
+	closed      bool
+	dropped     atomic.Uint64
+	overflow    OverflowPolicy
+	names       map[string]bool
+	trxs        map[int]bool
+	unsubscribe Unsubscribe
+}
+
+// Events opens a new EventStream with the given configuration. Close the stream when it is not needed anymore.
+func (n *notifier) Events(config EventStreamConfig) *EventStream {
+	bufferSize := config.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = DefaultEventBufferSize
+	}
+	events := make(chan Event, bufferSize)
+	result := &EventStream{
+		C:        events,
+		events:   events,
+		done:     make(chan struct{}),
+		overflow: config.Overflow,
+	}
+	if len(config.Names) > 0 {
+		result.names = make(map[string]bool, len(config.Names))
+		for _, name := range config.Names {
+			result.names[name] = true
+		}
+	}
+	if len(config.TRXs) > 0 {
+		result.trxs = make(map[int]bool, len(config.TRXs))
+		for _, trx := range config.TRXs {
+			result.trxs[trx] = true
+		}
+	}
+	adapter := &eventAdapter{stream: result}
+	if result.selectsStreams() {
+		result.unsubscribe = n.Subscribe(&streamEventAdapter{eventAdapter: adapter, copySamples: n.samplePool != nil})
+	} else {
+		result.unsubscribe = n.Subscribe(adapter)
+	}
+	return result
+}
+
+// streamEventNames are the names of the events for binary messages.
+var streamEventNames = []string{"iq_stream", "audio_stream", "tx_chrono"}
+
+// selectsStreams indicates if this stream selects any events for binary messages. Only then the stream needs its
+// own stream dispatcher.
+func (s *EventStream) selectsStreams() bool {
+	if s.names == nil {
+		return true
+	}
+	for _, name := range streamEventNames {
+		if s.names[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// Close stops the delivery of events and closes the channel C.
+func (s *EventStream) Close() {
+	s.closeOnce.Do(func() {
+		s.unsubscribe()
+		close(s.done)
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		s.closed = true
+		close(s.events)
+	})
+}
+
+// Dropped returns the number of events that were discarded because the buffer was full.
+func (s *EventStream) Dropped() uint64 {
+	return s.dropped.Load()
+}
+
+func (s *EventStream) selects(e Event) bool {
+	if s.names != nil && !s.names[e.EventName()] {
+		return false
+	}
+	if s.trxs == nil {
+		return true
+	}
+	if trxEvent, ok := e.(trxEvent); ok {
+		return s.trxs[trxEvent.eventTRX()]
+	}
+	return true
+}
+
+// selectsTRXEvent is like selects for an event with the given name and TRX, before the event is created.
+func (s *EventStream) selectsTRXEvent(name string, trx int) bool {
+	if s.names != nil && !s.names[name] {
+		return false
+	}
+	return s.trxs == nil || s.trxs[trx]
+}
+
+func (s *EventStream) deliver(e Event) {
+	if !s.selects(e) {
+		return
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.closed {
+		return
+	}
+
+	switch s.overflow {
+	case Block:
+		select {
+		case s.events <- e:
+		case <-s.done:
+		}
+	case DropOldest:
+		for {
+			select {
+			case s.events <- e:
+				return
+			default:
+			}
+			select {
+			case <-s.events:
+				s.dropped.Add(1)
+			default:
+			}
+		}
+	default:
+		select {
+		case s.events <- e:
+		default:
+			s.dropped.Add(1)
+		}
+	}
+}
+
+// eventAdapter implements all listener interfaces for text messages and forwards the notifications as events to an
+// EventStream. The events and adapter methods of the registered TCI messages are generated (see events_gen.go), only
+// those of the manual listeners, the connection, and the binary messages are written by hand.
+type eventAdapter struct {
+	stream *EventStream
+}
+
+// streamEventAdapter additionally implements the listener interfaces for binary messages. It is only used if the
+// EventStream selects any events for binary messages.
+type streamEventAdapter struct {
+	*eventAdapter
+	// copySamples indicates that the samples of binary messages are pooled and must be copied, because the events
+	// are consumed after the listener returned.
+	copySamples bool
+}
+
+func (a *eventAdapter) emit(e Event) {
+	a.stream.deliver(e)
+}
+
+// ConnectedEvent is sent when the TCI connection is established or disconnected.
+type ConnectedEvent struct {
+	Connected bool
+}
+
+// EventName returns "connected", this event is not related to a TCI message.
+func (ConnectedEvent) EventName() string { return "connected" }
+
+// ReconnectFailedEvent is sent when an attempt to reconnect to the TCI server failed.
+type ReconnectFailedEvent struct {
+	ReconnectAttempt
+}
+
+// EventName returns "reconnect_failed", this event is not related to a TCI message.
+func (ReconnectFailedEvent) EventName() string { return "reconnect_failed" }
+
+// ModesEvent is sent when a MODULATIONS_LIST message is received from the TCI server.
+type ModesEvent struct {
+	Modes []Mode
+}
+
+// EventName returns the name of the TCI message.
+func (ModesEvent) EventName() string { return "modulations_list" }
+
+// DriveEvent is sent when a DRIVE message is received from a TCI 1.4 server.
+type DriveEvent struct {
+	Percent int
+}
+
+// EventName returns the name of the TCI message.
+func (DriveEvent) EventName() string { return "drive" }
+
+// TRXDriveEvent is sent when a DRIVE message for a certain TRX is received from the TCI server. (since TCI 1.5)
+type TRXDriveEvent struct {
+	TRX     int
+	Percent int
+}
+
+// EventName returns the name of the TCI message.
+func (TRXDriveEvent) EventName() string { return "drive" }
+
+func (e TRXDriveEvent) eventTRX() int { return e.TRX }
+
+// TuneDriveEvent is sent when a TUNE_DRIVE message is received from a TCI 1.4 server.
+type TuneDriveEvent struct {
+	Percent int
+}
+
+// EventName returns the name of the TCI message.
+func (TuneDriveEvent) EventName() string { return "tune_drive" }
+
+// TRXTuneDriveEvent is sent when a TUNE_DRIVE message for a certain TRX is received from the TCI server. (since TCI 1.5)
+type TRXTuneDriveEvent struct {
+	TRX     int
+	Percent int
+}
+
+// EventName returns the name of the TCI message.
+func (TRXTuneDriveEvent) EventName() string { return "tune_drive" }
+
+func (e TRXTuneDriveEvent) eventTRX() int { return e.TRX }
+
+// IQDataEvent is sent when IQ data is received from the TCI server.
+type IQDataEvent struct {
+	TRX        int
+	SampleRate IQSampleRate
+	Data       []float32
+}
+
+// EventName returns "iq_stream", this event is not related to a text message.
+func (IQDataEvent) EventName() string { return "iq_stream" }
+
+func (e IQDataEvent) eventTRX() int { return e.TRX }
+
+// RXAudioEvent is sent when RX audio data is received from the TCI server.
+type RXAudioEvent struct {
+	TRX        int
+	SampleRate AudioSampleRate
+	Samples    []float32
+}
+
+// EventName returns "audio_stream", this event is not related to a text message.
+func (RXAudioEvent) EventName() string { return "audio_stream" }
+
+func (e RXAudioEvent) eventTRX() int { return e.TRX }
+
+// TXChronoEvent is sent when a TX chrono message is received from the TCI server.
+type TXChronoEvent struct {
+	TRX                  int
+	SampleRate           AudioSampleRate
+	RequestedSampleCount uint32
+}
+
+// EventName returns "tx_chrono", this event is not related to a text message.
+func (TXChronoEvent) EventName() string { return "tx_chrono" }
+
+func (e TXChronoEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetModes(modes []Mode) {
+	a.emit(ModesEvent{Modes: modes})
+}
+
+func (a *eventAdapter) SetDrive(percent int) {
+	a.emit(DriveEvent{Percent: percent})
+}
+
+func (a *eventAdapter) SetTRXDrive(trx int, percent int) {
+	a.emit(TRXDriveEvent{TRX: trx, Percent: percent})
+}
+
+func (a *eventAdapter) SetTuneDrive(percent int) {
+	a.emit(TuneDriveEvent{Percent: percent})
+}
+
+func (a *eventAdapter) SetTRXTuneDrive(trx int, percent int) {
+	a.emit(TRXTuneDriveEvent{TRX: trx, Percent: percent})
+}
+
+func (a *streamEventAdapter) IQData(trx int, sampleRate IQSampleRate, data []float32) {
+	if !a.stream.selectsTRXEvent("iq_stream", trx) {
+		return
+	}
+	a.emit(IQDataEvent{TRX: trx, SampleRate: sampleRate, Data: a.samples(data)})
+}
+
+func (a *streamEventAdapter) RXAudio(trx int, sampleRate AudioSampleRate, samples []float32) {
+	if !a.stream.selectsTRXEvent("audio_stream", trx) {
+		return
+	}
+	a.emit(RXAudioEvent{TRX: trx, SampleRate: sampleRate, Samples: a.samples(samples)})
+}
+
+// samples returns the given samples, or a copy if the samples are pooled.
+func (a *streamEventAdapter) samples(samples []float32) []float32 {
+	if !a.copySamples {
+		return samples
+	}
+	return append([]float32(nil), samples...)
+}
+
+func (a *streamEventAdapter) TXChrono(trx int, sampleRate AudioSampleRate, requestedSampleCount uint32) {
+	a.emit(TXChronoEvent{TRX: trx, SampleRate: sampleRate, RequestedSampleCount: requestedSampleCount})
+}
+
+func (a *eventAdapter) Connected(connected bool) {
+	a.emit(ConnectedEvent{Connected: connected})
+}
+
+func (a *eventAdapter) ReconnectFailed(attempt ReconnectAttempt) {
+	a.emit(ReconnectFailedEvent{ReconnectAttempt: attempt})
+}
@@ -0,0 +1,1110 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package client
+
+// ProtocolEvent is sent when a PROTOCOL message is received from the TCI server.
+type ProtocolEvent struct {
+	Name    string
+	Version string
+}
+
+// EventName returns the name of the TCI message.
+func (ProtocolEvent) EventName() string { return "protocol" }
+
+func (a *eventAdapter) SetProtocol(name string, version string) {
+	a.emit(ProtocolEvent{Name: name, Version: version})
+}
+
+// VFOLimitsEvent is sent when a VFO_LIMITS message is received from the TCI server.
+type VFOLimitsEvent struct {
+	Min int
+	Max int
+}
+
+// EventName returns the name of the TCI message.
+func (VFOLimitsEvent) EventName() string { return "vfo_limits" }
+
+func (a *eventAdapter) SetVFOLimits(min int, max int) {
+	a.emit(VFOLimitsEvent{Min: min, Max: max})
+}
+
+// IFLimitsEvent is sent when an IF_LIMITS message is received from the TCI server.
+type IFLimitsEvent struct {
+	Min int
+	Max int
+}
+
+// EventName returns the name of the TCI message.
+func (IFLimitsEvent) EventName() string { return "if_limits" }
+
+func (a *eventAdapter) SetIFLimits(min int, max int) {
+	a.emit(IFLimitsEvent{Min: min, Max: max})
+}
+
+// TRXCountEvent is sent when a TRX_COUNT message is received from the TCI server.
+type TRXCountEvent struct {
+	Count int
+}
+
+// EventName returns the name of the TCI message.
+func (TRXCountEvent) EventName() string { return "trx_count" }
+
+func (a *eventAdapter) SetTRXCount(count int) {
+	a.emit(TRXCountEvent{Count: count})
+}
+
+// ChannelCountEvent is sent when a CHANNELS_COUNT message is received from the TCI server.
+type ChannelCountEvent struct {
+	Count int
+}
+
+// EventName returns the name of the TCI message.
+func (ChannelCountEvent) EventName() string { return "channels_count" }
+
+func (a *eventAdapter) SetChannelCount(count int) {
+	a.emit(ChannelCountEvent{Count: count})
+}
+
+// DeviceNameEvent is sent when a DEVICE message is received from the TCI server.
+type DeviceNameEvent struct {
+	Name string
+}
+
+// EventName returns the name of the TCI message.
+func (DeviceNameEvent) EventName() string { return "device" }
+
+func (a *eventAdapter) SetDeviceName(name string) {
+	a.emit(DeviceNameEvent{Name: name})
+}
+
+// RXOnlyEvent is sent when a RECEIVE_ONLY message is received from the TCI server.
+type RXOnlyEvent struct {
+	Value bool
+}
+
+// EventName returns the name of the TCI message.
+func (RXOnlyEvent) EventName() string { return "receive_only" }
+
+func (a *eventAdapter) SetRXOnly(value bool) {
+	a.emit(RXOnlyEvent{Value: value})
+}
+
+// TXEnableEvent is sent when a TX_ENABLE message is received from the TCI server.
+type TXEnableEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (TXEnableEvent) EventName() string { return "tx_enable" }
+
+func (e TXEnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetTXEnable(trx int, enabled bool) {
+	a.emit(TXEnableEvent{TRX: trx, Enabled: enabled})
+}
+
+// ReadyEvent is sent when a READY message is received from the TCI server.
+type ReadyEvent struct {
+}
+
+// EventName returns the name of the TCI message.
+func (ReadyEvent) EventName() string { return "ready" }
+
+func (a *eventAdapter) Ready() {
+	a.emit(ReadyEvent{})
+}
+
+// TXFootswitchEvent is sent when a TX_FOOTSWITCH message is received from the TCI server.
+type TXFootswitchEvent struct {
+	TRX     int
+	Pressed bool
+}
+
+// EventName returns the name of the TCI message.
+func (TXFootswitchEvent) EventName() string { return "tx_footswitch" }
+
+func (e TXFootswitchEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetTXFootswitch(trx int, pressed bool) {
+	a.emit(TXFootswitchEvent{TRX: trx, Pressed: pressed})
+}
+
+// StartEvent is sent when a START message is received from the TCI server.
+type StartEvent struct {
+}
+
+// EventName returns the name of the TCI message.
+func (StartEvent) EventName() string { return "start" }
+
+func (a *eventAdapter) Start() {
+	a.emit(StartEvent{})
+}
+
+// StopEvent is sent when a STOP message is received from the TCI server.
+type StopEvent struct {
+}
+
+// EventName returns the name of the TCI message.
+func (StopEvent) EventName() string { return "stop" }
+
+func (a *eventAdapter) Stop() {
+	a.emit(StopEvent{})
+}
+
+// DDSEvent is sent when a DDS message is received from the TCI server.
+type DDSEvent struct {
+	TRX       int
+	Frequency int
+}
+
+// EventName returns the name of the TCI message.
+func (DDSEvent) EventName() string { return "dds" }
+
+func (e DDSEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetDDS(trx int, frequency int) {
+	a.emit(DDSEvent{TRX: trx, Frequency: frequency})
+}
+
+// IFEvent is sent when an IF message is received from the TCI server.
+type IFEvent struct {
+	TRX       int
+	VFO       VFO
+	Frequency int
+}
+
+// EventName returns the name of the TCI message.
+func (IFEvent) EventName() string { return "if" }
+
+func (e IFEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetIF(trx int, vfo VFO, frequency int) {
+	a.emit(IFEvent{TRX: trx, VFO: vfo, Frequency: frequency})
+}
+
+// RITEnableEvent is sent when a RIT_ENABLE message is received from the TCI server.
+type RITEnableEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (RITEnableEvent) EventName() string { return "rit_enable" }
+
+func (e RITEnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRITEnable(trx int, enabled bool) {
+	a.emit(RITEnableEvent{TRX: trx, Enabled: enabled})
+}
+
+// ModeEvent is sent when a MODULATION message is received from the TCI server.
+type ModeEvent struct {
+	TRX  int
+	Mode Mode
+}
+
+// EventName returns the name of the TCI message.
+func (ModeEvent) EventName() string { return "modulation" }
+
+func (e ModeEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetMode(trx int, mode Mode) {
+	a.emit(ModeEvent{TRX: trx, Mode: mode})
+}
+
+// RXEnableEvent is sent when a RX_ENABLE message is received from the TCI server.
+type RXEnableEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (RXEnableEvent) EventName() string { return "rx_enable" }
+
+func (e RXEnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXEnable(trx int, enabled bool) {
+	a.emit(RXEnableEvent{TRX: trx, Enabled: enabled})
+}
+
+// XITEnableEvent is sent when a XIT_ENABLE message is received from the TCI server.
+type XITEnableEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (XITEnableEvent) EventName() string { return "xit_enable" }
+
+func (e XITEnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetXITEnable(trx int, enabled bool) {
+	a.emit(XITEnableEvent{TRX: trx, Enabled: enabled})
+}
+
+// SplitEnableEvent is sent when a SPLIT_ENABLE message is received from the TCI server.
+type SplitEnableEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (SplitEnableEvent) EventName() string { return "split_enable" }
+
+func (e SplitEnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetSplitEnable(trx int, enabled bool) {
+	a.emit(SplitEnableEvent{TRX: trx, Enabled: enabled})
+}
+
+// RITOffsetEvent is sent when a RIT_OFFSET message is received from the TCI server.
+type RITOffsetEvent struct {
+	TRX    int
+	Offset int
+}
+
+// EventName returns the name of the TCI message.
+func (RITOffsetEvent) EventName() string { return "rit_offset" }
+
+func (e RITOffsetEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRITOffset(trx int, offset int) {
+	a.emit(RITOffsetEvent{TRX: trx, Offset: offset})
+}
+
+// XITOffsetEvent is sent when a XIT_OFFSET message is received from the TCI server.
+type XITOffsetEvent struct {
+	TRX    int
+	Offset int
+}
+
+// EventName returns the name of the TCI message.
+func (XITOffsetEvent) EventName() string { return "xit_offset" }
+
+func (e XITOffsetEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetXITOffset(trx int, offset int) {
+	a.emit(XITOffsetEvent{TRX: trx, Offset: offset})
+}
+
+// RXChannelEnableEvent is sent when a RX_CHANNEL_ENABLE message is received from the TCI server.
+type RXChannelEnableEvent struct {
+	TRX     int
+	VFO     VFO
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (RXChannelEnableEvent) EventName() string { return "rx_channel_enable" }
+
+func (e RXChannelEnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXChannelEnable(trx int, vfo VFO, enabled bool) {
+	a.emit(RXChannelEnableEvent{TRX: trx, VFO: vfo, Enabled: enabled})
+}
+
+// RXFilterBandEvent is sent when a RX_FILTER_BAND message is received from the TCI server.
+type RXFilterBandEvent struct {
+	TRX int
+	Min int
+	Max int
+}
+
+// EventName returns the name of the TCI message.
+func (RXFilterBandEvent) EventName() string { return "rx_filter_band" }
+
+func (e RXFilterBandEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXFilterBand(trx int, min int, max int) {
+	a.emit(RXFilterBandEvent{TRX: trx, Min: min, Max: max})
+}
+
+// RXSMeterEvent is sent when a RX_SMETER message is received from the TCI server.
+type RXSMeterEvent struct {
+	TRX   int
+	VFO   VFO
+	Level int
+}
+
+// EventName returns the name of the TCI message.
+func (RXSMeterEvent) EventName() string { return "rx_smeter" }
+
+func (e RXSMeterEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXSMeter(trx int, vfo VFO, level int) {
+	a.emit(RXSMeterEvent{TRX: trx, VFO: vfo, Level: level})
+}
+
+// CWMacrosSpeedEvent is sent when a CW_MACROS_SPEED message is received from the TCI server.
+type CWMacrosSpeedEvent struct {
+	WPM int
+}
+
+// EventName returns the name of the TCI message.
+func (CWMacrosSpeedEvent) EventName() string { return "cw_macros_speed" }
+
+func (a *eventAdapter) SetCWMacrosSpeed(wpm int) {
+	a.emit(CWMacrosSpeedEvent{WPM: wpm})
+}
+
+// CWMacrosDelayEvent is sent when a CW_MACROS_DELAY message is received from the TCI server.
+type CWMacrosDelayEvent struct {
+	Delay int
+}
+
+// EventName returns the name of the TCI message.
+func (CWMacrosDelayEvent) EventName() string { return "cw_macros_delay" }
+
+func (a *eventAdapter) SetCWMacrosDelay(delay int) {
+	a.emit(CWMacrosDelayEvent{Delay: delay})
+}
+
+// CWMacrosEmptyEvent is sent when a CW_MACROS_EMPTY message is received from the TCI server.
+type CWMacrosEmptyEvent struct {
+}
+
+// EventName returns the name of the TCI message.
+func (CWMacrosEmptyEvent) EventName() string { return "cw_macros_empty" }
+
+func (a *eventAdapter) CWMacrosEmpty() {
+	a.emit(CWMacrosEmptyEvent{})
+}
+
+// TXEvent is sent when a TRX message is received from the TCI server.
+type TXEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (TXEvent) EventName() string { return "trx" }
+
+func (e TXEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetTX(trx int, enabled bool) {
+	a.emit(TXEvent{TRX: trx, Enabled: enabled})
+}
+
+// TuneEvent is sent when a TUNE message is received from the TCI server.
+type TuneEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (TuneEvent) EventName() string { return "tune" }
+
+func (e TuneEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetTune(trx int, enabled bool) {
+	a.emit(TuneEvent{TRX: trx, Enabled: enabled})
+}
+
+// StartIQEvent is sent when an IQ_START message is received from the TCI server.
+type StartIQEvent struct {
+	TRX int
+}
+
+// EventName returns the name of the TCI message.
+func (StartIQEvent) EventName() string { return "iq_start" }
+
+func (e StartIQEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) StartIQ(trx int) {
+	a.emit(StartIQEvent{TRX: trx})
+}
+
+// StopIQEvent is sent when an IQ_STOP message is received from the TCI server.
+type StopIQEvent struct {
+	TRX int
+}
+
+// EventName returns the name of the TCI message.
+func (StopIQEvent) EventName() string { return "iq_stop" }
+
+func (e StopIQEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) StopIQ(trx int) {
+	a.emit(StopIQEvent{TRX: trx})
+}
+
+// IQSampleRateEvent is sent when an IQ_SAMPLERATE message is received from the TCI server.
+type IQSampleRateEvent struct {
+	SampleRate IQSampleRate
+}
+
+// EventName returns the name of the TCI message.
+func (IQSampleRateEvent) EventName() string { return "iq_samplerate" }
+
+func (a *eventAdapter) SetIQSampleRate(sampleRate IQSampleRate) {
+	a.emit(IQSampleRateEvent{SampleRate: sampleRate})
+}
+
+// StartAudioEvent is sent when an AUDIO_START message is received from the TCI server.
+type StartAudioEvent struct {
+	TRX int
+}
+
+// EventName returns the name of the TCI message.
+func (StartAudioEvent) EventName() string { return "audio_start" }
+
+func (e StartAudioEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) StartAudio(trx int) {
+	a.emit(StartAudioEvent{TRX: trx})
+}
+
+// StopAudioEvent is sent when an AUDIO_STOP message is received from the TCI server.
+type StopAudioEvent struct {
+	TRX int
+}
+
+// EventName returns the name of the TCI message.
+func (StopAudioEvent) EventName() string { return "audio_stop" }
+
+func (e StopAudioEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) StopAudio(trx int) {
+	a.emit(StopAudioEvent{TRX: trx})
+}
+
+// AudioSampleRateEvent is sent when an AUDIO_SAMPLERATE message is received from the TCI server.
+type AudioSampleRateEvent struct {
+	SampleRate AudioSampleRate
+}
+
+// EventName returns the name of the TCI message.
+func (AudioSampleRateEvent) EventName() string { return "audio_samplerate" }
+
+func (a *eventAdapter) SetAudioSampleRate(sampleRate AudioSampleRate) {
+	a.emit(AudioSampleRateEvent{SampleRate: sampleRate})
+}
+
+// AudioStreamSampleTypeEvent is sent when an AUDIO_STREAM_SAMPLE_TYPE message is received from the TCI server.
+type AudioStreamSampleTypeEvent struct {
+	SampleType SampleType
+}
+
+// EventName returns the name of the TCI message.
+func (AudioStreamSampleTypeEvent) EventName() string { return "audio_stream_sample_type" }
+
+func (a *eventAdapter) SetAudioStreamSampleType(sampleType SampleType) {
+	a.emit(AudioStreamSampleTypeEvent{SampleType: sampleType})
+}
+
+// AudioStreamChannelsEvent is sent when an AUDIO_STREAM_CHANNELS message is received from the TCI server.
+type AudioStreamChannelsEvent struct {
+	Count int
+}
+
+// EventName returns the name of the TCI message.
+func (AudioStreamChannelsEvent) EventName() string { return "audio_stream_channels" }
+
+func (a *eventAdapter) SetAudioStreamChannels(count int) {
+	a.emit(AudioStreamChannelsEvent{Count: count})
+}
+
+// TXPowerEvent is sent when a TX_POWER message is received from the TCI server.
+type TXPowerEvent struct {
+	Watts float64
+}
+
+// EventName returns the name of the TCI message.
+func (TXPowerEvent) EventName() string { return "tx_power" }
+
+func (a *eventAdapter) SetTXPower(watts float64) {
+	a.emit(TXPowerEvent{Watts: watts})
+}
+
+// TXSWREvent is sent when a TX_SWR message is received from the TCI server.
+type TXSWREvent struct {
+	Ratio float64
+}
+
+// EventName returns the name of the TCI message.
+func (TXSWREvent) EventName() string { return "tx_swr" }
+
+func (a *eventAdapter) SetTXSWR(ratio float64) {
+	a.emit(TXSWREvent{Ratio: ratio})
+}
+
+// VolumeEvent is sent when a VOLUME message is received from the TCI server.
+type VolumeEvent struct {
+	DB int
+}
+
+// EventName returns the name of the TCI message.
+func (VolumeEvent) EventName() string { return "volume" }
+
+func (a *eventAdapter) SetVolume(dB int) {
+	a.emit(VolumeEvent{DB: dB})
+}
+
+// SquelchEnableEvent is sent when a SQL_ENABLE message is received from the TCI server.
+type SquelchEnableEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (SquelchEnableEvent) EventName() string { return "sql_enable" }
+
+func (e SquelchEnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetSquelchEnable(trx int, enabled bool) {
+	a.emit(SquelchEnableEvent{TRX: trx, Enabled: enabled})
+}
+
+// SquelchLevelEvent is sent when a SQL_LEVEL message is received from the TCI server.
+type SquelchLevelEvent struct {
+	DB int
+}
+
+// EventName returns the name of the TCI message.
+func (SquelchLevelEvent) EventName() string { return "sql_level" }
+
+func (a *eventAdapter) SetSquelchLevel(dB int) {
+	a.emit(SquelchLevelEvent{DB: dB})
+}
+
+// VFOFrequencyEvent is sent when a VFO message is received from the TCI server.
+type VFOFrequencyEvent struct {
+	TRX       int
+	VFO       VFO
+	Frequency int
+}
+
+// EventName returns the name of the TCI message.
+func (VFOFrequencyEvent) EventName() string { return "vfo" }
+
+func (e VFOFrequencyEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetVFOFrequency(trx int, vfo VFO, frequency int) {
+	a.emit(VFOFrequencyEvent{TRX: trx, VFO: vfo, Frequency: frequency})
+}
+
+// AppFocusEvent is sent when an APP_FOCUS message is received from the TCI server.
+type AppFocusEvent struct {
+	Focussed bool
+}
+
+// EventName returns the name of the TCI message.
+func (AppFocusEvent) EventName() string { return "app_focus" }
+
+func (a *eventAdapter) SetAppFocus(focussed bool) {
+	a.emit(AppFocusEvent{Focussed: focussed})
+}
+
+// MuteEvent is sent when a MUTE message is received from the TCI server.
+type MuteEvent struct {
+	Muted bool
+}
+
+// EventName returns the name of the TCI message.
+func (MuteEvent) EventName() string { return "mute" }
+
+func (a *eventAdapter) SetMute(muted bool) {
+	a.emit(MuteEvent{Muted: muted})
+}
+
+// RXMuteEvent is sent when a RX_MUTE message is received from the TCI server.
+type RXMuteEvent struct {
+	TRX   int
+	Muted bool
+}
+
+// EventName returns the name of the TCI message.
+func (RXMuteEvent) EventName() string { return "rx_mute" }
+
+func (e RXMuteEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXMute(trx int, muted bool) {
+	a.emit(RXMuteEvent{TRX: trx, Muted: muted})
+}
+
+// CTCSSEnableEvent is sent when a CTCSS_ENABLE message is received from the TCI server.
+type CTCSSEnableEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (CTCSSEnableEvent) EventName() string { return "ctcss_enable" }
+
+func (e CTCSSEnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetCTCSSEnable(trx int, enabled bool) {
+	a.emit(CTCSSEnableEvent{TRX: trx, Enabled: enabled})
+}
+
+// CTCSSModeEvent is sent when a CTCSS_MODE message is received from the TCI server.
+type CTCSSModeEvent struct {
+	TRX  int
+	Mode CTCSSMode
+}
+
+// EventName returns the name of the TCI message.
+func (CTCSSModeEvent) EventName() string { return "ctcss_mode" }
+
+func (e CTCSSModeEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetCTCSSMode(trx int, mode CTCSSMode) {
+	a.emit(CTCSSModeEvent{TRX: trx, Mode: mode})
+}
+
+// CTCSSRXToneEvent is sent when a CTCSS_RX_TONE message is received from the TCI server.
+type CTCSSRXToneEvent struct {
+	TRX  int
+	Tone CTCSSTone
+}
+
+// EventName returns the name of the TCI message.
+func (CTCSSRXToneEvent) EventName() string { return "ctcss_rx_tone" }
+
+func (e CTCSSRXToneEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetCTCSSRXTone(trx int, tone CTCSSTone) {
+	a.emit(CTCSSRXToneEvent{TRX: trx, Tone: tone})
+}
+
+// CTCSSTXToneEvent is sent when a CTCSS_TX_TONE message is received from the TCI server.
+type CTCSSTXToneEvent struct {
+	TRX  int
+	Tone CTCSSTone
+}
+
+// EventName returns the name of the TCI message.
+func (CTCSSTXToneEvent) EventName() string { return "ctcss_tx_tone" }
+
+func (e CTCSSTXToneEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetCTCSSTXTone(trx int, tone CTCSSTone) {
+	a.emit(CTCSSTXToneEvent{TRX: trx, Tone: tone})
+}
+
+// CTCSSLevelEvent is sent when a CTCSS_LEVEL message is received from the TCI server.
+type CTCSSLevelEvent struct {
+	TRX     int
+	Percent int
+}
+
+// EventName returns the name of the TCI message.
+func (CTCSSLevelEvent) EventName() string { return "ctcss_level" }
+
+func (e CTCSSLevelEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetCTCSSLevel(trx int, percent int) {
+	a.emit(CTCSSLevelEvent{TRX: trx, Percent: percent})
+}
+
+// ECoderSwitchRXEvent is sent when an ECODER_SWITCH_RX message is received from the TCI server.
+type ECoderSwitchRXEvent struct {
+	ECoder int
+	TRX    int
+}
+
+// EventName returns the name of the TCI message.
+func (ECoderSwitchRXEvent) EventName() string { return "ecoder_switch_rx" }
+
+func (e ECoderSwitchRXEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetECoderSwitchRX(ecoder int, trx int) {
+	a.emit(ECoderSwitchRXEvent{ECoder: ecoder, TRX: trx})
+}
+
+// ECoderSwitchChannelEvent is sent when an ECODER_SWITCH_CHANNEL message is received from the TCI server.
+type ECoderSwitchChannelEvent struct {
+	ECoder int
+	VFO    VFO
+}
+
+// EventName returns the name of the TCI message.
+func (ECoderSwitchChannelEvent) EventName() string { return "ecoder_switch_channel" }
+
+func (a *eventAdapter) SetECoderSwitchChannel(ecoder int, vfo VFO) {
+	a.emit(ECoderSwitchChannelEvent{ECoder: ecoder, VFO: vfo})
+}
+
+// RXVolumeEvent is sent when a RX_VOLUME message is received from the TCI server.
+type RXVolumeEvent struct {
+	TRX int
+	VFO VFO
+	DB  int
+}
+
+// EventName returns the name of the TCI message.
+func (RXVolumeEvent) EventName() string { return "rx_volume" }
+
+func (e RXVolumeEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXVolume(trx int, vfo VFO, dB int) {
+	a.emit(RXVolumeEvent{TRX: trx, VFO: vfo, DB: dB})
+}
+
+// RXBalanceEvent is sent when a RX_BALANCE message is received from the TCI server.
+type RXBalanceEvent struct {
+	TRX int
+	VFO VFO
+	DB  int
+}
+
+// EventName returns the name of the TCI message.
+func (RXBalanceEvent) EventName() string { return "rx_balance" }
+
+func (e RXBalanceEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXBalance(trx int, vfo VFO, dB int) {
+	a.emit(RXBalanceEvent{TRX: trx, VFO: vfo, DB: dB})
+}
+
+// RXSensorsEvent is sent when a RX_SENSORS message is received from the TCI server.
+type RXSensorsEvent struct {
+	TRX int
+	DBm float64
+}
+
+// EventName returns the name of the TCI message.
+func (RXSensorsEvent) EventName() string { return "rx_sensors" }
+
+func (e RXSensorsEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXSensors(trx int, dBm float64) {
+	a.emit(RXSensorsEvent{TRX: trx, DBm: dBm})
+}
+
+// RXChannelSensorsEvent is sent when a RX_CHANNEL_SENSORS message is received from the TCI server.
+type RXChannelSensorsEvent struct {
+	TRX int
+	VFO VFO
+	DBm float64
+}
+
+// EventName returns the name of the TCI message.
+func (RXChannelSensorsEvent) EventName() string { return "rx_channel_sensors" }
+
+func (e RXChannelSensorsEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXChannelSensors(trx int, vfo VFO, dBm float64) {
+	a.emit(RXChannelSensorsEvent{TRX: trx, VFO: vfo, DBm: dBm})
+}
+
+// TXSensorsEvent is sent when a TX_SENSORS message is received from the TCI server.
+type TXSensorsEvent struct {
+	TRX    int
+	MicdBm float64
+	TXRMS  float64
+	TXPeak float64
+	SWR    float64
+}
+
+// EventName returns the name of the TCI message.
+func (TXSensorsEvent) EventName() string { return "tx_sensors" }
+
+func (e TXSensorsEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetTXSensors(trx int, micdBm float64, txRMS float64, txPeak float64, swr float64) {
+	a.emit(TXSensorsEvent{TRX: trx, MicdBm: micdBm, TXRMS: txRMS, TXPeak: txPeak, SWR: swr})
+}
+
+// RXNBEnableEvent is sent when a RX_NB_ENABLE message is received from the TCI server.
+type RXNBEnableEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (RXNBEnableEvent) EventName() string { return "rx_nb_enable" }
+
+func (e RXNBEnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXNBEnable(trx int, enabled bool) {
+	a.emit(RXNBEnableEvent{TRX: trx, Enabled: enabled})
+}
+
+// RXNBParamsEvent is sent when a RX_NB_PARAM message is received from the TCI server.
+type RXNBParamsEvent struct {
+	TRX           int
+	Threshold     int
+	ImpulseLength int
+}
+
+// EventName returns the name of the TCI message.
+func (RXNBParamsEvent) EventName() string { return "rx_nb_param" }
+
+func (e RXNBParamsEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXNBParams(trx int, threshold int, impulseLength int) {
+	a.emit(RXNBParamsEvent{TRX: trx, Threshold: threshold, ImpulseLength: impulseLength})
+}
+
+// RXBinEnableEvent is sent when a RX_BIN_ENABLE message is received from the TCI server.
+type RXBinEnableEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (RXBinEnableEvent) EventName() string { return "rx_bin_enable" }
+
+func (e RXBinEnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXBinEnable(trx int, enabled bool) {
+	a.emit(RXBinEnableEvent{TRX: trx, Enabled: enabled})
+}
+
+// RXNREnableEvent is sent when a RX_NR_ENABLE message is received from the TCI server.
+type RXNREnableEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (RXNREnableEvent) EventName() string { return "rx_nr_enable" }
+
+func (e RXNREnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXNREnable(trx int, enabled bool) {
+	a.emit(RXNREnableEvent{TRX: trx, Enabled: enabled})
+}
+
+// RXANCEnableEvent is sent when a RX_ANC_ENABLE message is received from the TCI server.
+type RXANCEnableEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (RXANCEnableEvent) EventName() string { return "rx_anc_enable" }
+
+func (e RXANCEnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXANCEnable(trx int, enabled bool) {
+	a.emit(RXANCEnableEvent{TRX: trx, Enabled: enabled})
+}
+
+// RXANFEnableEvent is sent when a RX_ANF_ENABLE message is received from the TCI server.
+type RXANFEnableEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (RXANFEnableEvent) EventName() string { return "rx_anf_enable" }
+
+func (e RXANFEnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXANFEnable(trx int, enabled bool) {
+	a.emit(RXANFEnableEvent{TRX: trx, Enabled: enabled})
+}
+
+// RXAPFEnableEvent is sent when a RX_APF_ENABLE message is received from the TCI server.
+type RXAPFEnableEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (RXAPFEnableEvent) EventName() string { return "rx_apf_enable" }
+
+func (e RXAPFEnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXAPFEnable(trx int, enabled bool) {
+	a.emit(RXAPFEnableEvent{TRX: trx, Enabled: enabled})
+}
+
+// RXDSEEnableEvent is sent when a RX_DSE_ENABLE message is received from the TCI server.
+type RXDSEEnableEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (RXDSEEnableEvent) EventName() string { return "rx_dse_enable" }
+
+func (e RXDSEEnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXDSEEnable(trx int, enabled bool) {
+	a.emit(RXDSEEnableEvent{TRX: trx, Enabled: enabled})
+}
+
+// RXNFEnableEvent is sent when a RX_NF_ENABLE message is received from the TCI server.
+type RXNFEnableEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (RXNFEnableEvent) EventName() string { return "rx_nf_enable" }
+
+func (e RXNFEnableEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetRXNFEnable(trx int, enabled bool) {
+	a.emit(RXNFEnableEvent{TRX: trx, Enabled: enabled})
+}
+
+// TXFrequencyEvent is sent when a TX_FREQUENCY message is received from the TCI server.
+type TXFrequencyEvent struct {
+	TRX       int
+	Frequency int
+}
+
+// EventName returns the name of the TCI message.
+func (TXFrequencyEvent) EventName() string { return "tx_frequency" }
+
+func (e TXFrequencyEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetTXFrequency(trx int, frequency int) {
+	a.emit(TXFrequencyEvent{TRX: trx, Frequency: frequency})
+}
+
+// AGCModeEvent is sent when an AGC_MODE message is received from the TCI server.
+type AGCModeEvent struct {
+	TRX  int
+	Mode AGCMode
+}
+
+// EventName returns the name of the TCI message.
+func (AGCModeEvent) EventName() string { return "agc_mode" }
+
+func (e AGCModeEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetAGCMode(trx int, mode AGCMode) {
+	a.emit(AGCModeEvent{TRX: trx, Mode: mode})
+}
+
+// AGCGainEvent is sent when an AGC_GAIN message is received from the TCI server.
+type AGCGainEvent struct {
+	TRX int
+	DB  int
+}
+
+// EventName returns the name of the TCI message.
+func (AGCGainEvent) EventName() string { return "agc_gain" }
+
+func (e AGCGainEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetAGCGain(trx int, dB int) {
+	a.emit(AGCGainEvent{TRX: trx, DB: dB})
+}
+
+// LockEvent is sent when a LOCK message is received from the TCI server.
+type LockEvent struct {
+	TRX     int
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (LockEvent) EventName() string { return "lock" }
+
+func (e LockEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetLock(trx int, enabled bool) {
+	a.emit(LockEvent{TRX: trx, Enabled: enabled})
+}
+
+// VFOLockEvent is sent when a VFO_LOCK message is received from the TCI server.
+type VFOLockEvent struct {
+	TRX     int
+	VFO     VFO
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (VFOLockEvent) EventName() string { return "vfo_lock" }
+
+func (e VFOLockEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) SetVFOLock(trx int, vfo VFO, enabled bool) {
+	a.emit(VFOLockEvent{TRX: trx, VFO: vfo, Enabled: enabled})
+}
+
+// MonitorEnableEvent is sent when a MON_ENABLE message is received from the TCI server.
+type MonitorEnableEvent struct {
+	Enabled bool
+}
+
+// EventName returns the name of the TCI message.
+func (MonitorEnableEvent) EventName() string { return "mon_enable" }
+
+func (a *eventAdapter) SetMonitorEnable(enabled bool) {
+	a.emit(MonitorEnableEvent{Enabled: enabled})
+}
+
+// MonitorVolumeEvent is sent when a MON_VOLUME message is received from the TCI server.
+type MonitorVolumeEvent struct {
+	DB int
+}
+
+// EventName returns the name of the TCI message.
+func (MonitorVolumeEvent) EventName() string { return "mon_volume" }
+
+func (a *eventAdapter) SetMonitorVolume(dB int) {
+	a.emit(MonitorVolumeEvent{DB: dB})
+}
+
+// DIGLOffsetEvent is sent when a DIGL_OFFSET message is received from the TCI server.
+type DIGLOffsetEvent struct {
+	Offset int
+}
+
+// EventName returns the name of the TCI message.
+func (DIGLOffsetEvent) EventName() string { return "digl_offset" }
+
+func (a *eventAdapter) SetDIGLOffset(offset int) {
+	a.emit(DIGLOffsetEvent{Offset: offset})
+}
+
+// DIGUOffsetEvent is sent when a DIGU_OFFSET message is received from the TCI server.
+type DIGUOffsetEvent struct {
+	Offset int
+}
+
+// EventName returns the name of the TCI message.
+func (DIGUOffsetEvent) EventName() string { return "digu_offset" }
+
+func (a *eventAdapter) SetDIGUOffset(offset int) {
+	a.emit(DIGUOffsetEvent{Offset: offset})
+}
+
+// TXStreamAudioBufferingEvent is sent when a TX_STREAM_AUDIO_BUFFERING message is received from the TCI server.
+type TXStreamAudioBufferingEvent struct {
+	Milliseconds int
+}
+
+// EventName returns the name of the TCI message.
+func (TXStreamAudioBufferingEvent) EventName() string { return "tx_stream_audio_buffering" }
+
+func (a *eventAdapter) SetTXStreamAudioBuffering(milliseconds int) {
+	a.emit(TXStreamAudioBufferingEvent{Milliseconds: milliseconds})
+}
+
+// ClickedOnSpotEvent is sent when a CLICKED_ON_SPOT message is received from the TCI server.
+type ClickedOnSpotEvent struct {
+	Callsign  string
+	Frequency int
+}
+
+// EventName returns the name of the TCI message.
+func (ClickedOnSpotEvent) EventName() string { return "clicked_on_spot" }
+
+func (a *eventAdapter) ClickedOnSpot(callsign string, frequency int) {
+	a.emit(ClickedOnSpotEvent{Callsign: callsign, Frequency: frequency})
+}
+
+// RXClickedOnSpotEvent is sent when a RX_CLICKED_ON_SPOT message is received from the TCI server.
+type RXClickedOnSpotEvent struct {
+	TRX       int
+	VFO       VFO
+	Callsign  string
+	Frequency int
+}
+
+// EventName returns the name of the TCI message.
+func (RXClickedOnSpotEvent) EventName() string { return "rx_clicked_on_spot" }
+
+func (e RXClickedOnSpotEvent) eventTRX() int { return e.TRX }
+
+func (a *eventAdapter) RXClickedOnSpot(trx int, vfo VFO, callsign string, frequency int) {
+	a.emit(RXClickedOnSpotEvent{TRX: trx, VFO: vfo, Callsign: callsign, Frequency: frequency})
+}
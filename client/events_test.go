@@ -0,0 +1,77 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvents_Filter(t *testing.T) {
+	n := newNotifier(nil, make(chan struct{}))
+	stream := n.Events(EventStreamConfig{Names: []string{"vfo", "mute"}, TRXs: []int{1}})
+	defer stream.Close()
+
+	n.handleIncomingMessage(NewCommandMessage("vfo", 0, 0, 7000000), n.listeners())
+	n.handleIncomingMessage(NewCommandMessage("dds", 1, 14000000), n.listeners())
+	n.handleIncomingMessage(NewCommandMessage("vfo", 1, 1, 14010000), n.listeners())
+	n.handleIncomingMessage(NewCommandMessage("mute", true), n.listeners())
+
+	assert.Equal(t, VFOFrequencyEvent{TRX: 1, VFO: VFOB, Frequency: 14010000}, <-stream.C)
+	assert.Equal(t, MuteEvent{Muted: true}, <-stream.C)
+	assert.Empty(t, stream.C)
+}
+
+func TestEvents_Overflow(t *testing.T) {
+	tt := []struct {
+		desc     string
+		policy   OverflowPolicy
+		expected Event
+	}{
+		{desc: "drop newest", policy: DropNewest, expected: VolumeEvent{DB: -10}},
+		{desc: "drop oldest", policy: DropOldest, expected: VolumeEvent{DB: -30}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.desc, func(t *testing.T) {
+			n := newNotifier(nil, make(chan struct{}))
+			stream := n.Events(EventStreamConfig{BufferSize: 1, Overflow: tc.policy})
+
+			n.handleIncomingMessage(NewCommandMessage("volume", -10), n.listeners())
+			n.handleIncomingMessage(NewCommandMessage("volume", -20), n.listeners())
+			n.handleIncomingMessage(NewCommandMessage("volume", -30), n.listeners())
+
+			assert.Equal(t, tc.expected, <-stream.C)
+			assert.Equal(t, uint64(2), stream.Dropped())
+			stream.Close()
+			_, open := <-stream.C
+			assert.False(t, open)
+		})
+	}
+}
+
+func TestEvents_StreamDispatcherOnlyForStreamEvents(t *testing.T) {
+	n := newNotifier(nil, make(chan struct{}))
+
+	control := n.Events(EventStreamConfig{Names: []string{"vfo"}})
+	assert.Empty(t, n.subscriptions.currentStreamDispatchers())
+	control.Close()
+
+	stream := n.Events(EventStreamConfig{Names: []string{"vfo", "iq_stream"}})
+	assert.Len(t, n.subscriptions.currentStreamDispatchers(), 1)
+	stream.Close()
+}
+
+func TestEvents_FilterBeforeCopyingSamples(t *testing.T) {
+	n := newNotifier(nil, make(chan struct{}))
+	stream := n.Events(EventStreamConfig{Names: []string{"iq_stream"}, TRXs: []int{1}})
+	defer stream.Close()
+	adapter := &streamEventAdapter{eventAdapter: &eventAdapter{stream: stream}, copySamples: true}
+	data := make([]float32, 2048)
+
+	allocs := testing.AllocsPerRun(100, func() {
+		adapter.IQData(0, IQSampleRate48k, data)
+		adapter.RXAudio(1, AudioSampleRate48k, data)
+	})
+
+	assert.Zero(t, allocs)
+	assert.Empty(t, stream.C)
+}
@@ -0,0 +1,363 @@
+// Command gen generates the Client methods, the listener interfaces, the emit functions, and the events of the client
+// package from the command registry. It is run by go generate in the client package directory.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/ftl/tci/client/internal/registry"
+)
+
+const header = "// Code generated by go run ./internal/gen; DO NOT EDIT.\n\n"
+
+func main() {
+	files, err := generate(registry.Commands)
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, content := range files {
+		err := os.WriteFile(filepath.Join(".", name), content, 0644)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// generate returns the content of all generated files by their name.
+func generate(commands []registry.Command) (map[string][]byte, error) {
+	result := make(map[string][]byte, len(templates))
+	for name, tmpl := range templates {
+		buffer := bytes.NewBufferString(header)
+		err := tmpl.Execute(buffer, commands)
+		if err != nil {
+			return nil, fmt.Errorf("cannot generate %s: %w", name, err)
+		}
+		content, err := format.Source(buffer.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("cannot format %s: %w", name, err)
+		}
+		result[name] = content
+	}
+	return result, nil
+}
+
+// goType describes how a value of a Go type is read from a TCI message.
+type goType struct {
+	// decode is the Message method that reads the raw value.
+	decode string
+	// convert converts the raw value into the Go type, %s is replaced with the raw value. Empty if no conversion is needed.
+	convert string
+	// zero is the zero value of the Go type.
+	zero string
+}
+
+var goTypes = map[string]goType{
+	"int":             {decode: "ToInt", zero: "0"},
+	"bool":            {decode: "ToBool", zero: "false"},
+	"float64":         {decode: "ToFloat", zero: "0"},
+	"string":          {decode: "ToString", zero: `""`},
+	"Mode":            {decode: "toMode", zero: `""`},
+	"AGCMode":         {decode: "toAGCMode", zero: `""`},
+	"SampleType":      {decode: "toSampleType", zero: "0"},
+	"VFO":             {decode: "ToInt", convert: "VFO(%s)", zero: "0"},
+	"IQSampleRate":    {decode: "ToInt", convert: "IQSampleRate(%s)", zero: "0"},
+	"AudioSampleRate": {decode: "ToInt", convert: "AudioSampleRate(%s)", zero: "0"},
+	"CTCSSMode":       {decode: "ToInt", convert: "CTCSSMode(%s)", zero: "0"},
+	"CTCSSTone":       {decode: "ToInt", convert: "CTCSSTone(%s)", zero: "0"},
+}
+
+func lookupType(name string) (goType, error) {
+	result, ok := goTypes[name]
+	if !ok {
+		return goType{}, fmt.Errorf("unsupported type %s", name)
+	}
+	return result, nil
+}
+
+var funcs = template.FuncMap{
+	"params": func(args []registry.Arg) string {
+		result := make([]string, len(args))
+		for i, arg := range args {
+			result[i] = arg.Name + " " + arg.Type
+		}
+		return strings.Join(result, ", ")
+	},
+	"names": func(args []registry.Arg) string {
+		result := make([]string, len(args))
+		for i, arg := range args {
+			result[i] = arg.Name
+		}
+		return strings.Join(result, ", ")
+	},
+	"types": func(args []registry.Arg) string {
+		result := make([]string, len(args))
+		for i, arg := range args {
+			result[i] = arg.Type
+		}
+		return strings.Join(result, ", ")
+	},
+	"converted": func(args []registry.Arg) (string, error) {
+		result := make([]string, len(args))
+		for i, arg := range args {
+			t, err := lookupType(arg.Type)
+			if err != nil {
+				return "", err
+			}
+			if t.convert == "" {
+				result[i] = arg.Name
+			} else {
+				result[i] = fmt.Sprintf(t.convert, arg.Name)
+			}
+		}
+		return strings.Join(result, ", "), nil
+	},
+	"zeros": func(args []registry.Arg) (string, error) {
+		result := make([]string, len(args))
+		for i, arg := range args {
+			t, err := lookupType(arg.Type)
+			if err != nil {
+				return "", err
+			}
+			result[i] = t.zero
+		}
+		return strings.Join(result, ", "), nil
+	},
+	"decode": func(arg registry.Arg) (string, error) {
+		t, err := lookupType(arg.Type)
+		return t.decode, err
+	},
+	"convert": func(arg registry.Arg) (string, error) {
+		t, err := lookupType(arg.Type)
+		if err != nil || t.convert == "" {
+			return arg.Name, err
+		}
+		return fmt.Sprintf(t.convert, arg.Name), nil
+	},
+	"needsConversion": func(arg registry.Arg) bool {
+		return goTypes[arg.Type].convert != ""
+	},
+	"field": field,
+	"hasTRX": func(args []registry.Arg) bool {
+		for _, arg := range args {
+			if arg.Name == "trx" {
+				return true
+			}
+		}
+		return false
+	},
+	"add": func(a, b int) int {
+		return a + b
+	},
+	"article": article,
+	"since":   since,
+	"upper":   strings.ToUpper,
+	"lower":   strings.ToLower,
+	"manual": func(command registry.Command, part string) bool {
+		switch part {
+		case "setter":
+			return command.Is(registry.ManualSetter)
+		case "getter":
+			return command.Is(registry.ManualGetter)
+		case "listener":
+			return command.Is(registry.ManualListener)
+		default:
+			panic("unknown part " + part)
+		}
+	},
+}
+
+// article returns the indefinite article for the given word.
+func article(word string) string {
+	if strings.ContainsAny(word[:1], "AEIOUaeiou") {
+		return "An"
+	}
+	return "A"
+}
+
+// fieldNames are the event field names of the arguments whose name is not simply capitalized.
+var fieldNames = map[string]string{
+	"trx":    "TRX",
+	"vfo":    "VFO",
+	"wpm":    "WPM",
+	"swr":    "SWR",
+	"ecoder": "ECoder",
+}
+
+// field returns the name of the event field for the given argument.
+func field(arg registry.Arg) string {
+	if result, ok := fieldNames[arg.Name]; ok {
+		return result
+	}
+	if len(arg.Name) > 2 && strings.HasPrefix(arg.Name, "tx") && strings.ToUpper(arg.Name[2:3]) == arg.Name[2:3] {
+		return "TX" + arg.Name[2:]
+	}
+	return strings.ToUpper(arg.Name[:1]) + arg.Name[1:]
+}
+
+// since returns the version annotation for commands that are not supported by all TCI versions.
+func since(command registry.Command) string {
+	if command.Version() <= registry.BaseVersion {
+		return ""
+	}
+	if command.Reference == registry.Unverified {
+		return fmt.Sprintf(" (since TCI %v, unverified)", command.Version())
+	}
+	return fmt.Sprintf(" (since TCI %v)", command.Version())
+}
+
+var templates = map[string]*template.Template{
+	"commands_gen.go":  template.Must(template.New("commands").Funcs(funcs).Parse(commandsTemplate)),
+	"listeners_gen.go": template.Must(template.New("listeners").Funcs(funcs).Parse(listenersTemplate)),
+	"subscribe_gen.go": template.Must(template.New("subscribe").Funcs(funcs).Parse(subscribeTemplate)),
+	"events_gen.go":    template.Must(template.New("events").Funcs(funcs).Parse(eventsTemplate)),
+}
+
+const commandsTemplate = `package client
+
+import "context"
+
+{{range .}}{{if and .Setter (not (manual . "setter"))}}
+// {{.Setter}} {{.SetterDoc}}{{since .}}
+func (c *Client) {{.Setter}}({{params .Args}}) error {
+	return c.{{.Setter}}Context(context.Background(){{range .Args}}, {{.Name}}{{end}})
+}
+
+// {{.Setter}}Context is like {{.Setter}}, but uses the given context.
+func (c *Client) {{.Setter}}Context(ctx context.Context{{range .Args}}, {{.Name}} {{.Type}}{{end}}) error {
+	{{- $name := .Name}}
+	{{- if .Verified}}{{range .Args}}{{if .Limited}}
+	if err := checkRange("{{$name}}", "{{.Name}}", {{.Name}}, {{.Min}}, {{.Max}}); err != nil {
+		return err
+	}
+	{{- end}}{{end}}{{end}}
+	_, err := c.command(ctx, "{{.Name}}"{{range .Args}}, {{.Name}}{{end}})
+	return err
+}
+{{end}}{{if and .Getter (not (manual . "getter"))}}{{$values := .Values}}{{$replyIndex := .ReplyIndex}}
+// {{.Getter}} {{.GetterDoc}}{{since .}}
+func (c *Client) {{.Getter}}({{params .Selectors}}) ({{types $values}}, error) {
+	return c.{{.Getter}}Context(context.Background(){{range .Selectors}}, {{.Name}}{{end}})
+}
+
+// {{.Getter}}Context is like {{.Getter}}, but uses the given context.
+func (c *Client) {{.Getter}}Context(ctx context.Context{{range .Selectors}}, {{.Name}} {{.Type}}{{end}}) ({{types $values}}, error) {
+	reply, err := c.request(ctx, "{{.Name}}"{{range .Selectors}}, {{.Name}}{{end}})
+	if err != nil {
+		return {{zeros $values}}, err
+	}
+	{{- if eq (len $values) 1}}{{$value := index $values 0}}
+	{{- if needsConversion $value}}
+	{{$value.Name}}, err := reply.{{decode $value}}({{$replyIndex}})
+	return {{convert $value}}, err
+	{{- else}}
+	return reply.{{decode $value}}({{$replyIndex}})
+	{{- end}}
+	{{- else}}
+	{{- range $i, $value := $values}}
+	{{$value.Name}}, err := reply.{{decode $value}}({{add $replyIndex $i}})
+	if err != nil {
+		return {{zeros $values}}, err
+	}
+	{{- end}}
+	return {{converted $values}}, nil
+	{{- end}}
+}
+{{end}}{{end}}`
+
+const listenersTemplate = `package client
+
+// emitCommand notifies the given listeners about the given message. It returns false if the message is unknown.
+func (n *notifier) emitCommand(msg Message, listeners []interface{}) (bool, error) {
+	switch msg.name {
+	{{- range .}}{{if .HasListener}}
+	case "{{.Name}}":
+		return true, n.{{.Emit}}(msg, listeners)
+	{{- end}}{{end}}
+	default:
+		return false, nil
+	}
+}
+
+// isCommandListener indicates if the given listener implements any of the generated listener interfaces.
+func isCommandListener(l interface{}) bool {
+	switch l.(type) {
+	{{- range .}}{{if and .HasListener (not (manual . "listener"))}}
+	case {{.Listener}}:
+		return true
+	{{- end}}{{end}}
+	default:
+		return false
+	}
+}
+{{range .}}{{if and .HasListener (not (manual . "listener"))}}
+// {{article .Listener}} {{.Listener}} is notified when {{article .Name | lower}} {{upper .Name}} message is received from the TCI server.{{since .}}
+type {{.Listener}} interface {
+	{{.ListenerMethod}}({{params .Args}})
+}
+{{if .Args}}
+func (n *notifier) {{.Emit}}(msg Message, listeners []interface{}) error {
+	{{- range $i, $arg := .Args}}
+	{{$arg.Name}}, err := msg.{{decode $arg}}({{$i}})
+	if err != nil {
+		return err
+	}
+	{{- end}}
+	for _, l := range listeners {
+		if listener, ok := l.({{.Listener}}); ok {
+			listener.{{.ListenerMethod}}({{converted .Args}})
+		}
+	}
+	return nil
+}
+{{else}}
+func (n *notifier) {{.Emit}}(_ Message, listeners []interface{}) error {
+	for _, l := range listeners {
+		if listener, ok := l.({{.Listener}}); ok {
+			listener.{{.ListenerMethod}}()
+		}
+	}
+	return nil
+}
+{{end}}{{end}}{{end}}`
+
+const subscribeTemplate = `package client
+{{range .}}{{if and .HasListener (not (manual . "listener"))}}
+// {{.Listener}}Func wraps a function with the {{.Listener}} interface.
+type {{.Listener}}Func func({{params .Args}})
+
+// {{.ListenerMethod}} implements the {{.Listener}} interface.
+func (f {{.Listener}}Func) {{.ListenerMethod}}({{params .Args}}) {
+	f({{names .Args}})
+}
+
+// On{{.Method}} subscribes the given function as {{.Listener}}.
+func (n *notifier) On{{.Method}}(f func({{params .Args}})) Unsubscribe {
+	return n.Subscribe({{.Listener}}Func(f))
+}
+{{end}}{{end}}`
+
+const eventsTemplate = `package client
+{{range .}}{{if and .HasListener (not (manual . "listener"))}}
+// {{.Method}}Event is sent when {{article .Name | lower}} {{upper .Name}} message is received from the TCI server.
+type {{.Method}}Event struct {
+	{{- range .Args}}
+	{{field .}} {{.Type}}
+	{{- end}}
+}
+
+// EventName returns the name of the TCI message.
+func ({{.Method}}Event) EventName() string { return "{{.Name}}" }
+{{if hasTRX .Args}}
+func (e {{.Method}}Event) eventTRX() int { return e.TRX }
+{{end}}
+func (a *eventAdapter) {{.ListenerMethod}}({{params .Args}}) {
+	a.emit({{.Method}}Event{ {{- range $i, $arg := .Args}}{{if $i}}, {{end}}{{field $arg}}: {{$arg.Name}}{{end -}} })
+}
+{{end}}{{end}}`
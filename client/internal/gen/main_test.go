@@ -0,0 +1,37 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ftl/tci/client/internal/registry"
+)
+
+func TestGeneratedFilesAreUpToDate(t *testing.T) {
+	files, err := generate(registry.Commands)
+	require.NoError(t, err)
+
+	for name, expected := range files {
+		actual, err := os.ReadFile(filepath.Join("..", "..", name))
+		require.NoError(t, err, name)
+		assert.Equal(t, string(expected), string(actual), "%s is outdated, run go generate in the client package", name)
+	}
+}
+
+func TestGenerate_UnsupportedType(t *testing.T) {
+	commands := []registry.Command{
+		{
+			Name:      "unsupported",
+			Method:    "Unsupported",
+			Args:      []registry.Arg{{Name: "value", Type: "complex128"}},
+			Direction: registry.FromServer,
+		},
+	}
+
+	_, err := generate(commands)
+	assert.Error(t, err)
+}
@@ -0,0 +1,1082 @@
+// Package registry describes all TCI commands and messages that are supported by the client package.
+//
+// The Client methods, the listener interfaces, the emit functions, and the events of the client package are generated
+// from the Commands table (see client/internal/gen). Parts that cannot be described declaratively are marked as manual
+// and are written by hand.
+package registry
+
+import "strings"
+
+// BaseVersion is the TCI protocol version that is assumed if a command does not declare a minimum version.
+const BaseVersion = 1.4
+
+// Unverified is the Reference of a command whose MinVersion and argument ranges are not backed by a TCI protocol
+// document. The client does not enforce the MinVersion and the argument ranges of unverified commands.
+const Unverified = "unverified"
+
+// Direction defines in which direction a command or message is exchanged between the client and the TCI server.
+type Direction int
+
+// All directions.
+const (
+	// ToServer commands are only sent by the client.
+	ToServer Direction = 1 << iota
+	// FromServer messages are only sent by the TCI server, the client provides a listener interface for them.
+	FromServer
+	// Bidirectional commands are sent by the client and reported by the TCI server.
+	Bidirectional = ToServer | FromServer
+)
+
+// Manual marks the parts of a command that are written by hand instead of being generated.
+type Manual int
+
+// The parts that can be written by hand.
+const (
+	// ManualSetter marks a setter that needs additional logic (e.g. resynchronization or escaping).
+	ManualSetter Manual = 1 << iota
+	// ManualGetter marks a getter that needs additional logic (e.g. version-dependent arguments).
+	ManualGetter
+	// ManualListener marks a listener interface and emit function that need additional logic.
+	ManualListener
+)
+
+// DeviceLimit identifies a limit of the connected device that applies to the value of an argument.
+// The limits are announced by the TCI server when the connection is established.
+type DeviceLimit int
+
+// All device limits.
+const (
+	// NoDeviceLimit means that the value does not depend on the device.
+	NoDeviceLimit DeviceLimit = iota
+	// TRXIndex values must be less than the device's TRX count.
+	TRXIndex
+	// VFOIndex values must be less than the device's channel count.
+	VFOIndex
+	// VFOFrequency values must be within the device's VFO limits.
+	VFOFrequency
+	// IFFrequency values must be within the device's IF limits.
+	IFFrequency
+	// SupportedMode values must be in the device's modulations list.
+	SupportedMode
+	// Transmit values must not enable the transmitter of a receive-only device.
+	Transmit
+)
+
+// Arg describes an argument of a command.
+type Arg struct {
+	// Name is the name of the argument as it is used in the Go API.
+	Name string
+	// Type is the Go type of the argument.
+	Type string
+	// Selector indicates that the argument selects the entity (e.g. TRX, VFO) the command refers to.
+	// Selectors are part of a request, all other arguments are part of the reply.
+	Selector bool
+	// Limited indicates that the value of the argument must be in the range [Min, Max].
+	Limited  bool
+	Min, Max int
+	// Device is the limit of the connected device that applies to the value of the argument.
+	Device DeviceLimit
+}
+
+// Command describes a TCI command or message.
+type Command struct {
+	// Name is the name of the command as it is used in the TCI protocol.
+	Name string
+	// Method is the base name for the Go API: the listener interface is called <Method>Listener, the
+	// emit function emit<Method>.
+	Method string
+	// Args are the arguments of the command, selectors first.
+	Args []Arg
+	// MinVersion is the minimum TCI protocol version that supports the command. Zero means BaseVersion.
+	MinVersion float64
+	// Reference names the TCI protocol document that specifies MinVersion and the argument ranges, or Unverified.
+	// It is required for commands beyond TCI 1.6; the older commands were taken over from the hand-written client.
+	Reference string
+	// Direction defines if the command is sent by the client, by the server, or both.
+	Direction Direction
+	// Setter is the name of the Client method that sends the command, empty if there is none.
+	Setter string
+	// SetterDoc is the documentation of the setter, without the leading method name.
+	SetterDoc string
+	// Getter is the name of the Client method that requests the current value, empty if there is none.
+	Getter string
+	// GetterDoc is the documentation of the getter, without the leading method name.
+	GetterDoc string
+	// Notification is the name of the listener method. If empty, "Set"+Method is used.
+	Notification string
+	// Manual marks the parts that are written by hand.
+	Manual Manual
+}
+
+// Version returns the minimum TCI protocol version that supports the command.
+func (c Command) Version() float64 {
+	if c.MinVersion == 0 {
+		return BaseVersion
+	}
+	return c.MinVersion
+}
+
+// Verified indicates if MinVersion and the argument ranges of the command are backed by a TCI protocol document.
+func (c Command) Verified() bool {
+	return c.Reference != Unverified
+}
+
+// Is indicates if the given part of the command is written by hand.
+func (c Command) Is(manual Manual) bool {
+	return c.Manual&manual != 0
+}
+
+// HasListener indicates if the TCI server sends this message, i.e. if there is a listener interface for it.
+func (c Command) HasListener() bool {
+	return c.Direction&FromServer != 0
+}
+
+// Listener returns the name of the listener interface.
+func (c Command) Listener() string {
+	return c.Method + "Listener"
+}
+
+// ListenerMethod returns the name of the listener interface's method.
+func (c Command) ListenerMethod() string {
+	if c.Notification != "" {
+		return c.Notification
+	}
+	return "Set" + c.Method
+}
+
+// Emit returns the name of the emit function.
+func (c Command) Emit() string {
+	return "emit" + c.Method
+}
+
+// Selectors returns the arguments that select the entity the command refers to.
+func (c Command) Selectors() []Arg {
+	return c.Args[:c.ReplyIndex()]
+}
+
+// Values returns the arguments that carry the values of the command.
+func (c Command) Values() []Arg {
+	return c.Args[c.ReplyIndex():]
+}
+
+// ReplyIndex returns the index of the first value in the reply to a request.
+func (c Command) ReplyIndex() int {
+	for i, arg := range c.Args {
+		if !arg.Selector {
+			return i
+		}
+	}
+	return len(c.Args)
+}
+
+// Lookup returns the command with the given name. The lookup is case-insensitive.
+func Lookup(name string) (Command, bool) {
+	command, ok := commandsByName[strings.ToLower(name)]
+	return command, ok
+}
+
+var commandsByName = func() map[string]Command {
+	result := make(map[string]Command, len(Commands))
+	for _, command := range Commands {
+		result[command.Name] = command
+	}
+	return result
+}()
+
+func selector(name, typ string) Arg {
+	return Arg{Name: name, Type: typ, Selector: true}
+}
+
+func value(name, typ string) Arg {
+	return Arg{Name: name, Type: typ}
+}
+
+func limited(name, typ string, min, max int) Arg {
+	return Arg{Name: name, Type: typ, Limited: true, Min: min, Max: max}
+}
+
+// limitedBy returns a copy of the argument that is subject to the given device limit.
+func (a Arg) limitedBy(limit DeviceLimit) Arg {
+	a.Device = limit
+	return a
+}
+
+var (
+	trx     = selector("trx", "int").limitedBy(TRXIndex)
+	vfo     = selector("vfo", "VFO").limitedBy(VFOIndex)
+	enabled = value("enabled", "bool")
+)
+
+// Commands contains all TCI commands and messages that are supported by the client package.
+var Commands = []Command{
+	{
+		Name:      "protocol",
+		Method:    "Protocol",
+		Args:      []Arg{value("name", "string"), value("version", "string")},
+		Direction: FromServer,
+	},
+	{
+		Name:      "vfo_limits",
+		Method:    "VFOLimits",
+		Args:      []Arg{value("min", "int"), value("max", "int")},
+		Direction: FromServer,
+	},
+	{
+		Name:      "if_limits",
+		Method:    "IFLimits",
+		Args:      []Arg{value("min", "int"), value("max", "int")},
+		Direction: FromServer,
+	},
+	{
+		Name:      "trx_count",
+		Method:    "TRXCount",
+		Args:      []Arg{value("count", "int")},
+		Direction: FromServer,
+	},
+	{
+		Name:      "channels_count",
+		Method:    "ChannelCount",
+		Args:      []Arg{value("count", "int")},
+		Direction: FromServer,
+	},
+	{
+		Name:      "device",
+		Method:    "DeviceName",
+		Args:      []Arg{value("name", "string")},
+		Direction: FromServer,
+	},
+	{
+		Name:      "receive_only",
+		Method:    "RXOnly",
+		Args:      []Arg{value("value", "bool")},
+		Direction: FromServer,
+	},
+	{
+		Name:      "modulations_list",
+		Method:    "Modes",
+		Args:      []Arg{value("modes", "[]Mode")},
+		Direction: FromServer,
+		Manual:    ManualListener,
+	},
+	{
+		Name:      "tx_enable",
+		Method:    "TXEnable",
+		Args:      []Arg{trx, enabled},
+		Direction: FromServer,
+	},
+	{
+		Name:         "ready",
+		Method:       "Ready",
+		Direction:    FromServer,
+		Notification: "Ready",
+	},
+	{
+		Name:      "tx_footswitch",
+		Method:    "TXFootswitch",
+		Args:      []Arg{trx, value("pressed", "bool")},
+		Direction: FromServer,
+	},
+	{
+		Name:         "start",
+		Method:       "Start",
+		Direction:    Bidirectional,
+		Setter:       "Start",
+		SetterDoc:    "starts the SDR operation.",
+		Notification: "Start",
+	},
+	{
+		Name:         "stop",
+		Method:       "Stop",
+		Direction:    Bidirectional,
+		Setter:       "Stop",
+		SetterDoc:    "stops the SDR operation.",
+		Notification: "Stop",
+	},
+	{
+		Name:      "dds",
+		Method:    "DDS",
+		Args:      []Arg{trx, value("frequency", "int").limitedBy(VFOFrequency)},
+		Direction: Bidirectional,
+		Setter:    "SetDDS",
+		SetterDoc: "sets the center frequency of the given TRX's panorama.",
+		Getter:    "DDS",
+		GetterDoc: "reads the center frequency of the given TRX's panorama.",
+	},
+	{
+		Name:      "if",
+		Method:    "IF",
+		Args:      []Arg{trx, vfo, value("frequency", "int").limitedBy(IFFrequency)},
+		Direction: Bidirectional,
+		Setter:    "SetIF",
+		SetterDoc: "sets the tuning frequency of the given TRX's vfo.",
+		Getter:    "IF",
+		GetterDoc: "reads the tuning frequency of the given TRX's vfo.",
+	},
+	{
+		Name:      "rit_enable",
+		Method:    "RITEnable",
+		Args:      []Arg{trx, enabled},
+		Direction: Bidirectional,
+		Setter:    "SetRITEnable",
+		SetterDoc: "enables the RIT of the given TRX.",
+		Getter:    "RITEnable",
+		GetterDoc: "reads the RIT enable state of the given TRX.",
+	},
+	{
+		Name:      "modulation",
+		Method:    "Mode",
+		Args:      []Arg{trx, value("mode", "Mode").limitedBy(SupportedMode)},
+		Direction: Bidirectional,
+		Setter:    "SetMode",
+		SetterDoc: "sets the mode of the given TRX.",
+		Getter:    "Mode",
+		GetterDoc: "reads the mode of the given TRX.",
+	},
+	{
+		Name:      "rx_enable",
+		Method:    "RXEnable",
+		Args:      []Arg{trx, enabled},
+		Direction: Bidirectional,
+		Setter:    "SetRXEnable",
+		SetterDoc: "enables the RX of the given TRX.",
+		Getter:    "RXEnable",
+		GetterDoc: "reads the RX enable state of the given TRX.",
+	},
+	{
+		Name:      "xit_enable",
+		Method:    "XITEnable",
+		Args:      []Arg{trx, enabled},
+		Direction: Bidirectional,
+		Setter:    "SetXITEnable",
+		SetterDoc: "enables the XIT of the given TRX.",
+		Getter:    "XITEnable",
+		GetterDoc: "reads the XIT enable state of the given TRX.",
+	},
+	{
+		Name:      "split_enable",
+		Method:    "SplitEnable",
+		Args:      []Arg{trx, enabled},
+		Direction: Bidirectional,
+		Setter:    "SetSplitEnable",
+		SetterDoc: "enables the split mode of the given TRX. When split mode is enabled, VFOB is used for transmitting.",
+		Getter:    "SplitEnable",
+		GetterDoc: "reads the split mode enable state of the given TRX. When split mode is enabled, VFOB is used for transmitting.",
+	},
+	{
+		Name:      "rit_offset",
+		Method:    "RITOffset",
+		Args:      []Arg{trx, value("offset", "int")},
+		Direction: Bidirectional,
+		Setter:    "SetRITOffset",
+		SetterDoc: "sets the RIT offset in Hz for the given TRX.",
+		Getter:    "RITOffset",
+		GetterDoc: "reads the RIT offset in Hz for the given TRX.",
+	},
+	{
+		Name:      "xit_offset",
+		Method:    "XITOffset",
+		Args:      []Arg{trx, value("offset", "int")},
+		Direction: Bidirectional,
+		Setter:    "SetXITOffset",
+		SetterDoc: "sets the XIT offset in Hz for the given TRX.",
+		Getter:    "XITOffset",
+		GetterDoc: "reads the XIT offset in Hz for the given TRX.",
+	},
+	{
+		Name:      "rx_channel_enable",
+		Method:    "RXChannelEnable",
+		Args:      []Arg{trx, vfo, enabled},
+		Direction: Bidirectional,
+		Setter:    "SetRXChannelEnable",
+		SetterDoc: "enables the given TRX's additional RX channel with the given index.",
+		Getter:    "RXChannelEnable",
+		GetterDoc: "reads the enable state of the given TRX's additional RX channel with the given index.",
+	},
+	{
+		Name:      "rx_filter_band",
+		Method:    "RXFilterBand",
+		Args:      []Arg{trx, value("min", "int"), value("max", "int")},
+		Direction: Bidirectional,
+		Setter:    "SetRXFilterBand",
+		SetterDoc: "sets the IF filter boundaries of the given TRX using the given limit frequencies in Hz.",
+		Getter:    "RXFilterBand",
+		GetterDoc: "reads the IF filter boundaries of the given TRX.",
+	},
+	{
+		Name:      "rx_smeter",
+		Method:    "RXSMeter",
+		Args:      []Arg{trx, vfo, value("level", "int")},
+		Direction: Bidirectional,
+		Setter:    "SetRXSMeter",
+		SetterDoc: "sets the signal level of the given TRX's RX channel with the given index.",
+		Getter:    "RXSMeter",
+		GetterDoc: "reads the signal level of the given TRX's RX channel with the given index.",
+	},
+	{
+		Name:      "cw_macros_speed",
+		Method:    "CWMacrosSpeed",
+		Args:      []Arg{value("wpm", "int")},
+		Direction: Bidirectional,
+		Setter:    "SetCWMacrosSpeed",
+		SetterDoc: "sets the speed in WPM for CW macros.",
+		Getter:    "CWMacrosSpeed",
+		GetterDoc: "reads the speed in WPM for CW macros.",
+	},
+	{
+		Name:      "cw_macros_delay",
+		Method:    "CWMacrosDelay",
+		Args:      []Arg{value("delay", "int")},
+		Direction: Bidirectional,
+		Setter:    "SetCWMacrosDelay",
+		SetterDoc: "sets the delay between keying the TRX and transmitting a CW macros in milliseconds.",
+		Getter:    "CWMacrosDelay",
+		GetterDoc: "reads the delay for transmitting CW macros in milliseconds.",
+	},
+	{
+		Name:         "cw_macros_empty",
+		Method:       "CWMacrosEmpty",
+		MinVersion:   1.5,
+		Direction:    FromServer,
+		Notification: "CWMacrosEmpty",
+	},
+	{
+		Name:      "trx",
+		Method:    "TX",
+		Args:      []Arg{trx, enabled.limitedBy(Transmit)},
+		Direction: Bidirectional,
+		Setter:    "SetTX",
+		Getter:    "TX",
+		GetterDoc: "reads the current state of the given TRX's transmitter.",
+		Manual:    ManualSetter,
+	},
+	{
+		Name:      "tune",
+		Method:    "Tune",
+		Args:      []Arg{trx, enabled.limitedBy(Transmit)},
+		Direction: Bidirectional,
+		Setter:    "SetTune",
+		SetterDoc: "enables the given TRX's transmitter in tuning.",
+		Getter:    "Tune",
+		GetterDoc: "reads the current state of the given TRX's tuning transmitter.",
+	},
+	{
+		// Before TCI 1.5, the drive commands have no TRX argument.
+		Name:      "drive",
+		Method:    "Drive",
+		Args:      []Arg{trx, limited("percent", "int", 0, 100)},
+		Direction: Bidirectional,
+		Setter:    "SetDrive",
+		Getter:    "Drive",
+		Manual:    ManualSetter | ManualGetter | ManualListener,
+	},
+	{
+		Name:      "tune_drive",
+		Method:    "TuneDrive",
+		Args:      []Arg{trx, limited("percent", "int", 0, 100)},
+		Direction: Bidirectional,
+		Setter:    "SetTuneDrive",
+		Getter:    "TuneDrive",
+		Manual:    ManualSetter | ManualGetter | ManualListener,
+	},
+	{
+		Name:         "iq_start",
+		Method:       "StartIQ",
+		Args:         []Arg{trx},
+		Direction:    Bidirectional,
+		Setter:       "StartIQ",
+		Notification: "StartIQ",
+		Manual:       ManualSetter,
+	},
+	{
+		Name:         "iq_stop",
+		Method:       "StopIQ",
+		Args:         []Arg{trx},
+		Direction:    Bidirectional,
+		Setter:       "StopIQ",
+		Notification: "StopIQ",
+		Manual:       ManualSetter,
+	},
+	{
+		Name:      "iq_samplerate",
+		Method:    "IQSampleRate",
+		Args:      []Arg{value("sampleRate", "IQSampleRate")},
+		Direction: Bidirectional,
+		Setter:    "SetIQSampleRate",
+		Getter:    "IQSampleRate",
+		GetterDoc: "reads the sample rate for IQ data.",
+		Manual:    ManualSetter,
+	},
+	{
+		Name:         "audio_start",
+		Method:       "StartAudio",
+		Args:         []Arg{trx},
+		Direction:    Bidirectional,
+		Setter:       "StartAudio",
+		Notification: "StartAudio",
+		Manual:       ManualSetter,
+	},
+	{
+		Name:         "audio_stop",
+		Method:       "StopAudio",
+		Args:         []Arg{trx},
+		Direction:    Bidirectional,
+		Setter:       "StopAudio",
+		Notification: "StopAudio",
+		Manual:       ManualSetter,
+	},
+	{
+		Name:      "audio_samplerate",
+		Method:    "AudioSampleRate",
+		Args:      []Arg{value("sampleRate", "AudioSampleRate")},
+		Direction: Bidirectional,
+		Setter:    "SetAudioSampleRate",
+		Getter:    "AudioSampleRate",
+		GetterDoc: "reads the sample rate for Audio data.",
+		Manual:    ManualSetter,
+	},
+	{
+		Name:       "audio_stream_sample_type",
+		Method:     "AudioStreamSampleType",
+		Args:       []Arg{value("sampleType", "SampleType")},
+		MinVersion: 1.6,
+		Direction:  Bidirectional,
+		Setter:     "SetAudioStreamSampleType",
+		Getter:     "AudioStreamSampleType",
+		GetterDoc:  "reads the sample type for the RX audio stream.",
+		Manual:     ManualSetter,
+	},
+	{
+		Name:       "audio_stream_channels",
+		Method:     "AudioStreamChannels",
+		Args:       []Arg{value("count", "int")},
+		MinVersion: 1.6,
+		Direction:  Bidirectional,
+		Setter:     "SetAudioStreamChannels",
+		Getter:     "AudioStreamChannels",
+		GetterDoc:  "reads the number of channels for the RX audio stream.",
+		Manual:     ManualSetter,
+	},
+	{
+		Name:      "tx_power",
+		Method:    "TXPower",
+		Args:      []Arg{value("watts", "float64")},
+		Direction: FromServer,
+	},
+	{
+		Name:      "tx_swr",
+		Method:    "TXSWR",
+		Args:      []Arg{value("ratio", "float64")},
+		Direction: FromServer,
+	},
+	{
+		Name:      "volume",
+		Method:    "Volume",
+		Args:      []Arg{limited("dB", "int", -60, 0)},
+		Direction: Bidirectional,
+		Setter:    "SetVolume",
+		SetterDoc: "sets the main volume in dB (range from -60dB to 0dB).",
+		Getter:    "Volume",
+		GetterDoc: "reads the main volume in dB (range from -60dB to 0dB).",
+	},
+	{
+		Name:      "sql_enable",
+		Method:    "SquelchEnable",
+		Args:      []Arg{trx, enabled},
+		Direction: Bidirectional,
+		Setter:    "SetSquelchEnable",
+		SetterDoc: "enables the given TRX's squelch.",
+		Getter:    "SquelchEnable",
+		GetterDoc: "reads the enable state of the given TRX's squelch.",
+	},
+	{
+		Name:      "sql_level",
+		Method:    "SquelchLevel",
+		Args:      []Arg{limited("dB", "int", -140, 0)},
+		Direction: Bidirectional,
+		Setter:    "SetSquelchLevel",
+		SetterDoc: "sets the squelch threshold in dB (range from -140dB to 0dB).",
+		Getter:    "SquelchLevel",
+		GetterDoc: "reads the squelch threshold in dB (range from -140dB to 0dB).",
+	},
+	{
+		Name:      "vfo",
+		Method:    "VFOFrequency",
+		Args:      []Arg{trx, vfo, value("frequency", "int").limitedBy(VFOFrequency)},
+		Direction: Bidirectional,
+		Setter:    "SetVFOFrequency",
+		SetterDoc: "sets the tuning frequency of the given TRX's vfo.",
+		Getter:    "VFOFrequency",
+		GetterDoc: "reads the tuning frequency of the given TRX's vfo.",
+	},
+	{
+		Name:      "app_focus",
+		Method:    "AppFocus",
+		Args:      []Arg{value("focussed", "bool")},
+		Direction: FromServer,
+	},
+	{
+		Name:      "mute",
+		Method:    "Mute",
+		Args:      []Arg{value("muted", "bool")},
+		Direction: Bidirectional,
+		Setter:    "SetMute",
+		SetterDoc: "mutes the main volume.",
+		Getter:    "Mute",
+		GetterDoc: "reads main volume's mute state.",
+	},
+	{
+		Name:      "rx_mute",
+		Method:    "RXMute",
+		Args:      []Arg{trx, value("muted", "bool")},
+		Direction: Bidirectional,
+		Setter:    "SetRXMute",
+		SetterDoc: "mutes the given TRX's receiver.",
+		Getter:    "RXMute",
+		GetterDoc: "reads given TRX's receiver mute state.",
+	},
+	{
+		Name:      "ctcss_enable",
+		Method:    "CTCSSEnable",
+		Args:      []Arg{trx, enabled},
+		Direction: Bidirectional,
+		Setter:    "SetCTCSSEnable",
+		SetterDoc: "enables CTCSS for the given TRX.",
+		Getter:    "CTCSSEnable",
+		GetterDoc: "reads enable state of CTCSS for the given TRX.",
+	},
+	{
+		Name:      "ctcss_mode",
+		Method:    "CTCSSMode",
+		Args:      []Arg{trx, value("mode", "CTCSSMode")},
+		Direction: Bidirectional,
+		Setter:    "SetCTCSSMode",
+		SetterDoc: "sets the CTCSS mode of the given TRX.",
+		Getter:    "CTCSSMode",
+		GetterDoc: "reads the CTCSS mode of the given TRX.",
+	},
+	{
+		Name:      "ctcss_rx_tone",
+		Method:    "CTCSSRXTone",
+		Args:      []Arg{trx, value("tone", "CTCSSTone")},
+		Direction: Bidirectional,
+		Setter:    "SetCTCSSRXTone",
+		SetterDoc: "sets the given TRX's CTCSS subtone for receiving.",
+		Getter:    "CTCSSRXTone",
+		GetterDoc: "reads the given TRX's CTCSS subtone for receiving.",
+	},
+	{
+		Name:      "ctcss_tx_tone",
+		Method:    "CTCSSTXTone",
+		Args:      []Arg{trx, value("tone", "CTCSSTone")},
+		Direction: Bidirectional,
+		Setter:    "SetCTCSSTXTone",
+		SetterDoc: "sets the given TRX's CTCSS subtone for transmitting.",
+		Getter:    "CTCSSTXTone",
+		GetterDoc: "reads the given TRX's CTCSS subtone for transmitting.",
+	},
+	{
+		Name:      "ctcss_level",
+		Method:    "CTCSSLevel",
+		Args:      []Arg{trx, limited("percent", "int", 0, 100)},
+		Direction: Bidirectional,
+		Setter:    "SetCTCSSLevel",
+		SetterDoc: "sets the given TRX's CTCSS subtone level for transmitting in percent.",
+		Getter:    "CTCSSLevel",
+		GetterDoc: "reads the given TRX's CTCSS subtone level for transmitting in percent.",
+	},
+	{
+		Name:      "ecoder_switch_rx",
+		Method:    "ECoderSwitchRX",
+		Args:      []Arg{selector("ecoder", "int"), value("trx", "int").limitedBy(TRXIndex)},
+		Direction: Bidirectional,
+		Setter:    "SetECoderSwitchRX",
+		SetterDoc: "assigns the given TRX's control to the given E-Coder.",
+		Getter:    "ECoderSwitchRX",
+		GetterDoc: "reads which TRX is assigned to the given E-Coder.",
+	},
+	{
+		Name:      "ecoder_switch_channel",
+		Method:    "ECoderSwitchChannel",
+		Args:      []Arg{selector("ecoder", "int"), value("vfo", "VFO").limitedBy(VFOIndex)},
+		Direction: Bidirectional,
+		Setter:    "SetECoderSwitchChannel",
+		SetterDoc: "assigns the given channel's control to the given E-Coder.",
+		Getter:    "ECoderSwitchChannel",
+		GetterDoc: "reads which channel is assigned to the given E-Coder.",
+	},
+	{
+		Name:      "rx_volume",
+		Method:    "RXVolume",
+		Args:      []Arg{trx, vfo, limited("dB", "int", -60, 0)},
+		Direction: Bidirectional,
+		Setter:    "SetRXVolume",
+		SetterDoc: "sets the given TRX's channel volume in dB (range from -60dB to 0dB).",
+		Getter:    "RXVolume",
+		GetterDoc: "reads the given TRX's channel volume in dB (range from -60dB to 0dB).",
+	},
+	{
+		Name:      "rx_balance",
+		Method:    "RXBalance",
+		Args:      []Arg{trx, vfo, limited("dB", "int", -40, 40)},
+		Direction: Bidirectional,
+		Setter:    "SetRXBalance",
+		SetterDoc: "sets the given TRX's channel balance in dB (range from -40dB to 40dB).",
+		Getter:    "RXBalance",
+		GetterDoc: "reads the given TRX's channel balance in dB (range from -40dB to 40dB).",
+	},
+	{
+		Name:       "rx_sensors",
+		Method:     "RXSensors",
+		Args:       []Arg{trx, value("dBm", "float64")},
+		MinVersion: 1.5,
+		Direction:  FromServer,
+	},
+	{
+		Name:       "rx_channel_sensors",
+		Method:     "RXChannelSensors",
+		Args:       []Arg{trx, vfo, value("dBm", "float64")},
+		MinVersion: 1.9,
+		Reference:  Unverified,
+		Direction:  FromServer,
+	},
+	{
+		Name:       "tx_sensors",
+		Method:     "TXSensors",
+		Args:       []Arg{trx, value("micdBm", "float64"), value("txRMS", "float64"), value("txPeak", "float64"), value("swr", "float64")},
+		MinVersion: 1.5,
+		Direction:  FromServer,
+	},
+	{
+		Name:       "rx_nb_enable",
+		Method:     "RXNBEnable",
+		Args:       []Arg{trx, enabled},
+		MinVersion: 1.6,
+		Direction:  Bidirectional,
+		Setter:     "SetRXNBEnable",
+		SetterDoc:  "enables/disables the given TRX's noise blanker.",
+		Getter:     "RXNBEnable",
+		GetterDoc:  "reads the given TRX's noise blanker enable state.",
+	},
+	{
+		Name:       "rx_nb_param",
+		Method:     "RXNBParams",
+		Args:       []Arg{trx, value("threshold", "int"), value("impulseLength", "int")},
+		MinVersion: 1.6,
+		Direction:  Bidirectional,
+		Setter:     "SetRXNBParams",
+		SetterDoc:  "sets the given TRX's noise blanker parameters.",
+		Getter:     "RXNBParams",
+		GetterDoc:  "reads the given TRX's noise blanker parameters.",
+	},
+	{
+		Name:       "rx_bin_enable",
+		Method:     "RXBinEnable",
+		Args:       []Arg{trx, enabled},
+		MinVersion: 1.6,
+		Direction:  Bidirectional,
+		Setter:     "SetRXBinEnable",
+		SetterDoc:  "enables/disables the given TRX's pseudo stereo for CW.",
+		Getter:     "RXBinEnable",
+		GetterDoc:  "reads the given TRX's pseudo stereo enable state.",
+	},
+	{
+		Name:       "rx_nr_enable",
+		Method:     "RXNREnable",
+		Args:       []Arg{trx, enabled},
+		MinVersion: 1.6,
+		Direction:  Bidirectional,
+		Setter:     "SetRXNREnable",
+		SetterDoc:  "enables/disables the given TRX's noise reduction.",
+		Getter:     "RXNREnable",
+		GetterDoc:  "reads the given TRX's noise reduction enable state.",
+	},
+	{
+		Name:       "rx_anc_enable",
+		Method:     "RXANCEnable",
+		Args:       []Arg{trx, enabled},
+		MinVersion: 1.6,
+		Direction:  Bidirectional,
+		Setter:     "SetRXANCEnable",
+		SetterDoc:  "enables/disables the given TRX's automatic noise cancellation.",
+		Getter:     "RXANCEnable",
+		GetterDoc:  "reads the given TRX's automatic noise cancellation enable state.",
+	},
+	{
+		Name:       "rx_anf_enable",
+		Method:     "RXANFEnable",
+		Args:       []Arg{trx, enabled},
+		MinVersion: 1.6,
+		Direction:  Bidirectional,
+		Setter:     "SetRXANFEnable",
+		SetterDoc:  "enables/disables the given TRX's automatic notch filter.",
+		Getter:     "RXANFEnable",
+		GetterDoc:  "reads the given TRX's automatic notch filter enable state.",
+	},
+	{
+		Name:       "rx_apf_enable",
+		Method:     "RXAPFEnable",
+		Args:       []Arg{trx, enabled},
+		MinVersion: 1.6,
+		Direction:  Bidirectional,
+		Setter:     "SetRXAPFEnable",
+		SetterDoc:  "enables/disables the given TRX's analogue peak filter.",
+		Getter:     "RXAPFEnable",
+		GetterDoc:  "reads the given TRX's analogue peak filter enable state.",
+	},
+	{
+		Name:       "rx_dse_enable",
+		Method:     "RXDSEEnable",
+		Args:       []Arg{trx, enabled},
+		MinVersion: 1.6,
+		Direction:  Bidirectional,
+		Setter:     "SetRXDSEEnable",
+		SetterDoc:  "enables/disables the given TRX's digital surround sound effect.",
+		Getter:     "RXDSEEnable",
+		GetterDoc:  "reads the given TRX's digital surround sound effect enable state.",
+	},
+	{
+		Name:       "rx_nf_enable",
+		Method:     "RXNFEnable",
+		Args:       []Arg{trx, enabled},
+		MinVersion: 1.6,
+		Direction:  Bidirectional,
+		Setter:     "SetRXNFEnable",
+		SetterDoc:  "enables/disables the given TRX's band notch filters.",
+		Getter:     "RXNFEnable",
+		GetterDoc:  "reads the given TRX's band notch filters enable state.",
+	},
+	{
+		Name:       "tx_frequency",
+		Method:     "TXFrequency",
+		Args:       []Arg{trx, value("frequency", "int")},
+		MinVersion: 1.6,
+		Direction:  FromServer,
+	},
+	{
+		Name:       "agc_mode",
+		Method:     "AGCMode",
+		Args:       []Arg{trx, value("mode", "AGCMode")},
+		MinVersion: 1.8,
+		Reference:  Unverified,
+		Direction:  Bidirectional,
+		Setter:     "SetAGCMode",
+		SetterDoc:  "sets the AGC mode of the given TRX.",
+		Getter:     "AGCMode",
+		GetterDoc:  "reads the AGC mode of the given TRX.",
+	},
+	{
+		Name:       "agc_gain",
+		Method:     "AGCGain",
+		Args:       []Arg{trx, value("dB", "int")},
+		MinVersion: 1.8,
+		Reference:  Unverified,
+		Direction:  Bidirectional,
+		Setter:     "SetAGCGain",
+		SetterDoc:  "sets the AGC gain of the given TRX in dB.",
+		Getter:     "AGCGain",
+		GetterDoc:  "reads the AGC gain of the given TRX in dB.",
+	},
+	{
+		Name:       "lock",
+		Method:     "Lock",
+		Args:       []Arg{trx, enabled},
+		MinVersion: 1.8,
+		Reference:  Unverified,
+		Direction:  Bidirectional,
+		Setter:     "SetLock",
+		SetterDoc:  "locks/unlocks the tuning of the given TRX.",
+		Getter:     "Lock",
+		GetterDoc:  "reads the tuning lock state of the given TRX.",
+	},
+	{
+		Name:       "vfo_lock",
+		Method:     "VFOLock",
+		Args:       []Arg{trx, vfo, enabled},
+		MinVersion: 1.9,
+		Reference:  Unverified,
+		Direction:  Bidirectional,
+		Setter:     "SetVFOLock",
+		SetterDoc:  "locks/unlocks the tuning of the given TRX's vfo.",
+		Getter:     "VFOLock",
+		GetterDoc:  "reads the tuning lock state of the given TRX's vfo.",
+	},
+	{
+		Name:       "mon_enable",
+		Method:     "MonitorEnable",
+		Args:       []Arg{enabled},
+		MinVersion: 1.8,
+		Reference:  Unverified,
+		Direction:  Bidirectional,
+		Setter:     "SetMonitorEnable",
+		SetterDoc:  "enables/disables the monitoring of the transmitted signal.",
+		Getter:     "MonitorEnable",
+		GetterDoc:  "reads the monitor enable state.",
+	},
+	{
+		Name:       "mon_volume",
+		Method:     "MonitorVolume",
+		Args:       []Arg{limited("dB", "int", -60, 0)},
+		MinVersion: 1.8,
+		Reference:  Unverified,
+		Direction:  Bidirectional,
+		Setter:     "SetMonitorVolume",
+		SetterDoc:  "sets the monitor volume in dB (range from -60dB to 0dB).",
+		Getter:     "MonitorVolume",
+		GetterDoc:  "reads the monitor volume in dB (range from -60dB to 0dB).",
+	},
+	{
+		Name:       "digl_offset",
+		Method:     "DIGLOffset",
+		Args:       []Arg{value("offset", "int")},
+		MinVersion: 1.8,
+		Reference:  Unverified,
+		Direction:  Bidirectional,
+		Setter:     "SetDIGLOffset",
+		SetterDoc:  "sets the frequency offset in Hz that is used in DIGL mode.",
+		Getter:     "DIGLOffset",
+		GetterDoc:  "reads the frequency offset in Hz that is used in DIGL mode.",
+	},
+	{
+		Name:       "digu_offset",
+		Method:     "DIGUOffset",
+		Args:       []Arg{value("offset", "int")},
+		MinVersion: 1.8,
+		Reference:  Unverified,
+		Direction:  Bidirectional,
+		Setter:     "SetDIGUOffset",
+		SetterDoc:  "sets the frequency offset in Hz that is used in DIGU mode.",
+		Getter:     "DIGUOffset",
+		GetterDoc:  "reads the frequency offset in Hz that is used in DIGU mode.",
+	},
+	{
+		Name:       "tx_stream_audio_buffering",
+		Method:     "TXStreamAudioBuffering",
+		Args:       []Arg{limited("milliseconds", "int", 50, 500)},
+		MinVersion: 1.9,
+		Reference:  Unverified,
+		Direction:  Bidirectional,
+		Setter:     "SetTXStreamAudioBuffering",
+		SetterDoc:  "sets the length of the TX audio stream buffer in milliseconds (range from 50ms to 500ms).",
+		Getter:     "TXStreamAudioBuffering",
+		GetterDoc:  "reads the length of the TX audio stream buffer in milliseconds (range from 50ms to 500ms).",
+	},
+	{
+		Name:      "cw_macros",
+		Method:    "CWMacro",
+		Args:      []Arg{trx, value("text", "string")},
+		Direction: ToServer,
+		Setter:    "SendCWMacro",
+		Manual:    ManualSetter,
+	},
+	{
+		Name:      "cw_msg",
+		Method:    "CWMessage",
+		Args:      []Arg{trx, value("before", "string"), value("callsign", "string"), value("after", "string")},
+		Direction: ToServer,
+		Setter:    "SendCWMessage",
+		Manual:    ManualSetter,
+	},
+	{
+		Name:      "callsign_send",
+		Method:    "Callsign",
+		Args:      []Arg{value("callsign", "string")},
+		Direction: ToServer,
+		Setter:    "SendCallsign",
+		Manual:    ManualSetter,
+	},
+	{
+		Name:      "cw_macros_stop",
+		Method:    "StopCW",
+		Direction: ToServer,
+		Setter:    "StopCW",
+		SetterDoc: "stops the current CW transmission.",
+	},
+	{
+		Name:       "cw_terminal",
+		Method:     "CWTerminal",
+		Args:       []Arg{enabled},
+		MinVersion: 1.5,
+		Direction:  ToServer,
+		Setter:     "SetCWTerminal",
+		SetterDoc:  "enables/disables the terminal mode for CW transmission.",
+	},
+	{
+		Name:      "cw_macros_speed_up",
+		Method:    "CWMacrosSpeedInc",
+		Args:      []Arg{value("delta", "int")},
+		Direction: ToServer,
+		Setter:    "CWMacrosSpeedInc",
+		SetterDoc: "increases the speed for CW macros by the given delta in WPM.",
+	},
+	{
+		Name:      "cw_macros_speed_down",
+		Method:    "CWMacrosSpeedDec",
+		Args:      []Arg{value("delta", "int")},
+		Direction: ToServer,
+		Setter:    "CWMacrosSpeedDec",
+		SetterDoc: "decreases the speed for CW macros by the given delta in WPM.",
+	},
+	{
+		Name:      "spot",
+		Method:    "Spot",
+		Args:      []Arg{selector("callsign", "string"), value("mode", "Mode"), value("frequency", "int"), value("color", "ARGB"), value("text", "string")},
+		Direction: ToServer,
+		Setter:    "AddSpot",
+		Manual:    ManualSetter,
+	},
+	{
+		Name:      "spot_delete",
+		Method:    "DeleteSpot",
+		Args:      []Arg{value("callsign", "string")},
+		Direction: ToServer,
+		Setter:    "DeleteSpot",
+		Manual:    ManualSetter,
+	},
+	{
+		Name:      "spot_clear",
+		Method:    "ClearSpots",
+		Direction: ToServer,
+		Setter:    "ClearSpots",
+		Manual:    ManualSetter,
+	},
+	{
+		Name:         "clicked_on_spot",
+		Method:       "ClickedOnSpot",
+		Args:         []Arg{value("callsign", "string"), value("frequency", "int")},
+		Direction:    FromServer,
+		Notification: "ClickedOnSpot",
+	},
+	{
+		Name:         "rx_clicked_on_spot",
+		Method:       "RXClickedOnSpot",
+		Args:         []Arg{trx, vfo, value("callsign", "string"), value("frequency", "int")},
+		MinVersion:   1.6,
+		Direction:    FromServer,
+		Notification: "RXClickedOnSpot",
+	},
+	{
+		Name:      "set_in_focus",
+		Method:    "BringToFront",
+		Direction: ToServer,
+		Setter:    "BringToFront",
+		SetterDoc: "brings main ExpertSDR window into the focus.",
+	},
+	{
+		Name:       "rx_sensors_enable",
+		Method:     "RXSensorsEnable",
+		Args:       []Arg{value("enabled", "bool"), value("milliseconds", "int")},
+		MinVersion: 1.5,
+		Direction:  ToServer,
+		Setter:     "SetRXSensorsEnable",
+		Manual:     ManualSetter,
+	},
+	{
+		Name:       "tx_sensors_enable",
+		Method:     "TXSensorsEnable",
+		Args:       []Arg{value("enabled", "bool"), value("milliseconds", "int")},
+		MinVersion: 1.5,
+		Direction:  ToServer,
+		Setter:     "SetTXSensorsEnable",
+		Manual:     ManualSetter,
+	},
+}
@@ -0,0 +1,92 @@
+package registry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommands_AreConsistent(t *testing.T) {
+	names := make(map[string]bool)
+	methods := make(map[string]bool)
+	for _, command := range Commands {
+		assert.False(t, names[command.Name], "%s: duplicate name", command.Name)
+		names[command.Name] = true
+		assert.False(t, methods[command.Method], "%s: duplicate method %s", command.Name, command.Method)
+		methods[command.Method] = true
+
+		assert.NotZero(t, command.Direction, "%s: missing direction", command.Name)
+		if command.Version() > 1.6 {
+			assert.NotEmpty(t, command.Reference, "%s: missing reference for TCI %v", command.Name, command.Version())
+		}
+		if command.Direction == FromServer {
+			assert.Empty(t, command.Setter, "%s: setter for a message that is only sent by the server", command.Name)
+			assert.Empty(t, command.Getter, "%s: getter for a message that is only sent by the server", command.Name)
+		} else {
+			assert.NotEmpty(t, command.Setter, "%s: missing setter", command.Name)
+		}
+		if !command.HasListener() {
+			assert.Empty(t, command.Getter, "%s: getter for a command that is never reported by the server", command.Name)
+			assert.False(t, command.Is(ManualListener), "%s: manual listener for a command that is never reported by the server", command.Name)
+		}
+		if command.Setter != "" && !command.Is(ManualSetter) {
+			assert.NotEmpty(t, command.SetterDoc, "%s: missing setter documentation", command.Name)
+		}
+		if command.Getter != "" && !command.Is(ManualGetter) {
+			assert.NotEmpty(t, command.GetterDoc, "%s: missing getter documentation", command.Name)
+			assert.NotEmpty(t, command.Values(), "%s: getter without values", command.Name)
+		}
+
+		valueSeen := false
+		for _, arg := range command.Args {
+			assert.NotEmpty(t, arg.Name, "%s: argument without name", command.Name)
+			assert.NotEmpty(t, arg.Type, "%s: argument %s without type", command.Name, arg.Name)
+			assert.False(t, arg.Selector && valueSeen, "%s: selector %s after a value", command.Name, arg.Name)
+			valueSeen = valueSeen || !arg.Selector
+			if arg.Limited {
+				assert.Less(t, arg.Min, arg.Max, "%s: invalid range for %s", command.Name, arg.Name)
+			}
+			switch arg.Name {
+			case "trx":
+				assert.Equal(t, TRXIndex, arg.Device, "%s: trx without device limit", command.Name)
+			case "vfo":
+				assert.Equal(t, VFOIndex, arg.Device, "%s: vfo without device limit", command.Name)
+			}
+		}
+	}
+}
+
+func TestReplyIndex(t *testing.T) {
+	tt := []struct {
+		name     string
+		expected int
+	}{
+		{"volume", 0},
+		{"dds", 1},
+		{"vfo", 2},
+		{"rx_filter_band", 1},
+		{"ecoder_switch_rx", 1},
+		{"drive", 1},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			command, ok := Lookup(tc.name)
+			assert.True(t, ok)
+			assert.Equal(t, tc.expected, command.ReplyIndex())
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	command, ok := Lookup("RX_NB_ENABLE")
+	assert.True(t, ok)
+	assert.Equal(t, "RXNBEnable", command.Method)
+	assert.Equal(t, 1.6, command.Version())
+
+	command, ok = Lookup("vfo")
+	assert.True(t, ok)
+	assert.Equal(t, BaseVersion, command.Version())
+
+	_, ok = Lookup("unknown")
+	assert.False(t, ok)
+}
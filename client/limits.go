@@ -0,0 +1,90 @@
+package client
+
+import (
+	"strings"
+
+	"github.com/ftl/tci/client/internal/registry"
+)
+
+// checkDeviceLimits validates the arguments of the given command against the limits of the connected device, if
+// enabled with WithDeviceLimits. For requests, only the selectors are validated. Commands whose arguments do not
+// match the registry (e.g. drive before TCI 1.5) are not validated.
+func (c *Client) checkDeviceLimits(cmd string, args []interface{}, request bool) error {
+	if !c.deviceLimits {
+		return nil
+	}
+	command, ok := registry.Lookup(cmd)
+	if !ok {
+		return nil
+	}
+	declared := command.Args
+	if request {
+		declared = command.Selectors()
+	}
+	if len(args) < len(declared) {
+		return nil
+	}
+	device := c.device.snapshot()
+	for i, arg := range declared {
+		err := checkDeviceLimit(device, command.Name, arg, args[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func checkDeviceLimit(device DeviceInfo, command string, arg registry.Arg, value interface{}) error {
+	switch arg.Device {
+	case registry.TRXIndex:
+		if device.TRXCount > 0 {
+			return checkIntRange(command, arg.Name, value, 0, device.TRXCount-1)
+		}
+	case registry.VFOIndex:
+		if device.ChannelCount > 0 {
+			return checkIntRange(command, arg.Name, value, 0, device.ChannelCount-1)
+		}
+	case registry.VFOFrequency:
+		if device.MaxVFOFrequency > device.MinVFOFrequency {
+			return checkIntRange(command, arg.Name, value, device.MinVFOFrequency, device.MaxVFOFrequency)
+		}
+	case registry.IFFrequency:
+		if device.MaxIFFrequency > device.MinIFFrequency {
+			return checkIntRange(command, arg.Name, value, device.MinIFFrequency, device.MaxIFFrequency)
+		}
+	case registry.SupportedMode:
+		mode, ok := value.(Mode)
+		if ok && len(device.Modes) > 0 && !containsMode(device.Modes, mode) {
+			return &DeviceError{Command: command, Argument: arg.Name, Value: mode, Reason: "is not in the modulations list of the device"}
+		}
+	case registry.Transmit:
+		enabled, ok := value.(bool)
+		if ok && enabled && device.RXOnly {
+			return &DeviceError{Command: command, Argument: arg.Name, Value: enabled, Reason: "is not possible with a receive-only device"}
+		}
+	}
+	return nil
+}
+
+// checkIntRange is like checkRange for int and VFO values. Values of other types are not checked.
+func checkIntRange(command string, argument string, value interface{}, min int, max int) error {
+	var v int
+	switch value := value.(type) {
+	case int:
+		v = value
+	case VFO:
+		v = int(value)
+	default:
+		return nil
+	}
+	return checkRange(command, argument, v, min, max)
+}
+
+func containsMode(modes []Mode, mode Mode) bool {
+	for _, m := range modes {
+		if strings.EqualFold(string(m), string(mode)) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package client_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ftl/tci/client"
+	"github.com/ftl/tci/client/clienttest"
+)
+
+func openLimitedTestClient(t *testing.T, handshake ...client.Message) (*clienttest.Server, *client.Client) {
+	t.Helper()
+	server := clienttest.NewServer(handshake...)
+	t.Cleanup(server.Close)
+	c, err := client.OpenURL(server.URL(), client.WithDeviceLimits(true))
+	require.NoError(t, err)
+	t.Cleanup(c.Disconnect)
+	return server, c
+}
+
+func TestDeviceLimits_RejectsInvalidArguments(t *testing.T) {
+	server, c := openLimitedTestClient(t)
+
+	tt := []struct {
+		desc     string
+		call     func() error
+		expected error
+	}{
+		{"vfo frequency too low", func() error { return c.SetVFOFrequency(0, client.VFOA, 9999) }, client.ErrArgumentOutOfRange},
+		{"vfo frequency too high", func() error { return c.SetVFOFrequency(0, client.VFOA, 30000001) }, client.ErrArgumentOutOfRange},
+		{"dds frequency", func() error { return c.SetDDS(1, 50000000) }, client.ErrArgumentOutOfRange},
+		{"if frequency", func() error { return c.SetIF(0, client.VFOB, 48001) }, client.ErrArgumentOutOfRange},
+		{"trx index", func() error { return c.SetVFOFrequency(2, client.VFOA, 7000000) }, client.ErrArgumentOutOfRange},
+		{"negative trx index", func() error { return c.SetRXEnable(-1, true) }, client.ErrArgumentOutOfRange},
+		{"vfo index", func() error { return c.SetIF(0, client.VFO(2), 0) }, client.ErrArgumentOutOfRange},
+		{"trx index in request", func() error { _, err := c.VFOFrequency(2, client.VFOA); return err }, client.ErrArgumentOutOfRange},
+		{"unsupported mode", func() error { return c.SetMode(0, client.ModeSPEC) }, client.ErrNotSupportedByDevice},
+	}
+	for _, tc := range tt {
+		t.Run(tc.desc, func(t *testing.T) {
+			assert.ErrorIs(t, tc.call(), tc.expected)
+		})
+	}
+	assert.Empty(t, server.Received())
+}
+
+func TestDeviceLimits_DescriptiveErrors(t *testing.T) {
+	_, c := openLimitedTestClient(t)
+
+	err := c.SetVFOFrequency(0, client.VFOA, 40000000)
+	var argumentError *client.ArgumentError
+	require.ErrorAs(t, err, &argumentError)
+	assert.Equal(t, "vfo: frequency 40000000 is out of range [10000, 30000000]", err.Error())
+
+	err = c.SetMode(1, client.ModeSPEC)
+	var deviceError *client.DeviceError
+	require.ErrorAs(t, err, &deviceError)
+	assert.Equal(t, "modulation: mode spec is not in the modulations list of the device", err.Error())
+}
+
+func TestDeviceLimits_AcceptsValidArguments(t *testing.T) {
+	server, c := openLimitedTestClient(t)
+
+	assert.NoError(t, c.SetVFOFrequency(1, client.VFOB, 30000000))
+	assert.NoError(t, c.SetIF(0, client.VFOA, -48000))
+	assert.NoError(t, c.SetMode(0, client.ModeDIGU))
+	assert.NoError(t, c.SetTX(0, true, client.SignalSourceMIC))
+	assert.Len(t, server.Received(), 4)
+}
+
+func TestDeviceLimits_RXOnly(t *testing.T) {
+	handshake := clienttest.DefaultHandshake()
+	for i, msg := range handshake {
+		if msg.Name() == "receive_only" {
+			handshake[i] = client.NewCommandMessage("receive_only", true)
+		}
+	}
+	server, c := openLimitedTestClient(t, handshake...)
+
+	assert.ErrorIs(t, c.SetTX(0, true, client.SignalSourceDefault), client.ErrNotSupportedByDevice)
+	assert.ErrorIs(t, c.SetTune(0, true), client.ErrNotSupportedByDevice)
+	assert.NoError(t, c.SetTX(0, false, client.SignalSourceDefault))
+	assert.Len(t, server.Received(), 1)
+}
+
+func TestDeviceLimits_DisabledByDefault(t *testing.T) {
+	server, c := openTestClient(t)
+
+	assert.NoError(t, c.SetVFOFrequency(0, client.VFOA, 5000))
+	assert.Len(t, server.Received(), 1)
+}
@@ -0,0 +1,2007 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package client
+
+// emitCommand notifies the given listeners about the given message. It returns false if the message is unknown.
+func (n *notifier) emitCommand(msg Message, listeners []interface{}) (bool, error) {
+	switch msg.name {
+	case "protocol":
+		return true, n.emitProtocol(msg, listeners)
+	case "vfo_limits":
+		return true, n.emitVFOLimits(msg, listeners)
+	case "if_limits":
+		return true, n.emitIFLimits(msg, listeners)
+	case "trx_count":
+		return true, n.emitTRXCount(msg, listeners)
+	case "channels_count":
+		return true, n.emitChannelCount(msg, listeners)
+	case "device":
+		return true, n.emitDeviceName(msg, listeners)
+	case "receive_only":
+		return true, n.emitRXOnly(msg, listeners)
+	case "modulations_list":
+		return true, n.emitModes(msg, listeners)
+	case "tx_enable":
+		return true, n.emitTXEnable(msg, listeners)
+	case "ready":
+		return true, n.emitReady(msg, listeners)
+	case "tx_footswitch":
+		return true, n.emitTXFootswitch(msg, listeners)
+	case "start":
+		return true, n.emitStart(msg, listeners)
+	case "stop":
+		return true, n.emitStop(msg, listeners)
+	case "dds":
+		return true, n.emitDDS(msg, listeners)
+	case "if":
+		return true, n.emitIF(msg, listeners)
+	case "rit_enable":
+		return true, n.emitRITEnable(msg, listeners)
+	case "modulation":
+		return true, n.emitMode(msg, listeners)
+	case "rx_enable":
+		return true, n.emitRXEnable(msg, listeners)
+	case "xit_enable":
+		return true, n.emitXITEnable(msg, listeners)
+	case "split_enable":
+		return true, n.emitSplitEnable(msg, listeners)
+	case "rit_offset":
+		return true, n.emitRITOffset(msg, listeners)
+	case "xit_offset":
+		return true, n.emitXITOffset(msg, listeners)
+	case "rx_channel_enable":
+		return true, n.emitRXChannelEnable(msg, listeners)
+	case "rx_filter_band":
+		return true, n.emitRXFilterBand(msg, listeners)
+	case "rx_smeter":
+		return true, n.emitRXSMeter(msg, listeners)
+	case "cw_macros_speed":
+		return true, n.emitCWMacrosSpeed(msg, listeners)
+	case "cw_macros_delay":
+		return true, n.emitCWMacrosDelay(msg, listeners)
+	case "cw_macros_empty":
+		return true, n.emitCWMacrosEmpty(msg, listeners)
+	case "trx":
+		return true, n.emitTX(msg, listeners)
+	case "tune":
+		return true, n.emitTune(msg, listeners)
+	case "drive":
+		return true, n.emitDrive(msg, listeners)
+	case "tune_drive":
+		return true, n.emitTuneDrive(msg, listeners)
+	case "iq_start":
+		return true, n.emitStartIQ(msg, listeners)
+	case "iq_stop":
+		return true, n.emitStopIQ(msg, listeners)
+	case "iq_samplerate":
+		return true, n.emitIQSampleRate(msg, listeners)
+	case "audio_start":
+		return true, n.emitStartAudio(msg, listeners)
+	case "audio_stop":
+		return true, n.emitStopAudio(msg, listeners)
+	case "audio_samplerate":
+		return true, n.emitAudioSampleRate(msg, listeners)
+	case "audio_stream_sample_type":
+		return true, n.emitAudioStreamSampleType(msg, listeners)
+	case "audio_stream_channels":
+		return true, n.emitAudioStreamChannels(msg, listeners)
+	case "tx_power":
+		return true, n.emitTXPower(msg, listeners)
+	case "tx_swr":
+		return true, n.emitTXSWR(msg, listeners)
+	case "volume":
+		return true, n.emitVolume(msg, listeners)
+	case "sql_enable":
+		return true, n.emitSquelchEnable(msg, listeners)
+	case "sql_level":
+		return true, n.emitSquelchLevel(msg, listeners)
+	case "vfo":
+		return true, n.emitVFOFrequency(msg, listeners)
+	case "app_focus":
+		return true, n.emitAppFocus(msg, listeners)
+	case "mute":
+		return true, n.emitMute(msg, listeners)
+	case "rx_mute":
+		return true, n.emitRXMute(msg, listeners)
+	case "ctcss_enable":
+		return true, n.emitCTCSSEnable(msg, listeners)
+	case "ctcss_mode":
+		return true, n.emitCTCSSMode(msg, listeners)
+	case "ctcss_rx_tone":
+		return true, n.emitCTCSSRXTone(msg, listeners)
+	case "ctcss_tx_tone":
+		return true, n.emitCTCSSTXTone(msg, listeners)
+	case "ctcss_level":
+		return true, n.emitCTCSSLevel(msg, listeners)
+	case "ecoder_switch_rx":
+		return true, n.emitECoderSwitchRX(msg, listeners)
+	case "ecoder_switch_channel":
+		return true, n.emitECoderSwitchChannel(msg, listeners)
+	case "rx_volume":
+		return true, n.emitRXVolume(msg, listeners)
+	case "rx_balance":
+		return true, n.emitRXBalance(msg, listeners)
+	case "rx_sensors":
+		return true, n.emitRXSensors(msg, listeners)
+	case "rx_channel_sensors":
+		return true, n.emitRXChannelSensors(msg, listeners)
+	case "tx_sensors":
+		return true, n.emitTXSensors(msg, listeners)
+	case "rx_nb_enable":
+		return true, n.emitRXNBEnable(msg, listeners)
+	case "rx_nb_param":
+		return true, n.emitRXNBParams(msg, listeners)
+	case "rx_bin_enable":
+		return true, n.emitRXBinEnable(msg, listeners)
+	case "rx_nr_enable":
+		return true, n.emitRXNREnable(msg, listeners)
+	case "rx_anc_enable":
+		return true, n.emitRXANCEnable(msg, listeners)
+	case "rx_anf_enable":
+		return true, n.emitRXANFEnable(msg, listeners)
+	case "rx_apf_enable":
+		return true, n.emitRXAPFEnable(msg, listeners)
+	case "rx_dse_enable":
+		return true, n.emitRXDSEEnable(msg, listeners)
+	case "rx_nf_enable":
+		return true, n.emitRXNFEnable(msg, listeners)
+	case "tx_frequency":
+		return true, n.emitTXFrequency(msg, listeners)
+	case "agc_mode":
+		return true, n.emitAGCMode(msg, listeners)
+	case "agc_gain":
+		return true, n.emitAGCGain(msg, listeners)
+	case "lock":
+		return true, n.emitLock(msg, listeners)
+	case "vfo_lock":
+		return true, n.emitVFOLock(msg, listeners)
+	case "mon_enable":
+		return true, n.emitMonitorEnable(msg, listeners)
+	case "mon_volume":
+		return true, n.emitMonitorVolume(msg, listeners)
+	case "digl_offset":
+		return true, n.emitDIGLOffset(msg, listeners)
+	case "digu_offset":
+		return true, n.emitDIGUOffset(msg, listeners)
+	case "tx_stream_audio_buffering":
+		return true, n.emitTXStreamAudioBuffering(msg, listeners)
+	case "clicked_on_spot":
+		return true, n.emitClickedOnSpot(msg, listeners)
+	case "rx_clicked_on_spot":
+		return true, n.emitRXClickedOnSpot(msg, listeners)
+	default:
+		return false, nil
+	}
+}
+
+// isCommandListener indicates if the given listener implements any of the generated listener interfaces.
+func isCommandListener(l interface{}) bool {
+	switch l.(type) {
+	case ProtocolListener:
+		return true
+	case VFOLimitsListener:
+		return true
+	case IFLimitsListener:
+		return true
+	case TRXCountListener:
+		return true
+	case ChannelCountListener:
+		return true
+	case DeviceNameListener:
+		return true
+	case RXOnlyListener:
+		return true
+	case TXEnableListener:
+		return true
+	case ReadyListener:
+		return true
+	case TXFootswitchListener:
+		return true
+	case StartListener:
+		return true
+	case StopListener:
+		return true
+	case DDSListener:
+		return true
+	case IFListener:
+		return true
+	case RITEnableListener:
+		return true
+	case ModeListener:
+		return true
+	case RXEnableListener:
+		return true
+	case XITEnableListener:
+		return true
+	case SplitEnableListener:
+		return true
+	case RITOffsetListener:
+		return true
+	case XITOffsetListener:
+		return true
+	case RXChannelEnableListener:
+		return true
+	case RXFilterBandListener:
+		return true
+	case RXSMeterListener:
+		return true
+	case CWMacrosSpeedListener:
+		return true
+	case CWMacrosDelayListener:
+		return true
+	case CWMacrosEmptyListener:
+		return true
+	case TXListener:
+		return true
+	case TuneListener:
+		return true
+	case StartIQListener:
+		return true
+	case StopIQListener:
+		return true
+	case IQSampleRateListener:
+		return true
+	case StartAudioListener:
+		return true
+	case StopAudioListener:
+		return true
+	case AudioSampleRateListener:
+		return true
+	case AudioStreamSampleTypeListener:
+		return true
+	case AudioStreamChannelsListener:
+		return true
+	case TXPowerListener:
+		return true
+	case TXSWRListener:
+		return true
+	case VolumeListener:
+		return true
+	case SquelchEnableListener:
+		return true
+	case SquelchLevelListener:
+		return true
+	case VFOFrequencyListener:
+		return true
+	case AppFocusListener:
+		return true
+	case MuteListener:
+		return true
+	case RXMuteListener:
+		return true
+	case CTCSSEnableListener:
+		return true
+	case CTCSSModeListener:
+		return true
+	case CTCSSRXToneListener:
+		return true
+	case CTCSSTXToneListener:
+		return true
+	case CTCSSLevelListener:
+		return true
+	case ECoderSwitchRXListener:
+		return true
+	case ECoderSwitchChannelListener:
+		return true
+	case RXVolumeListener:
+		return true
+	case RXBalanceListener:
+		return true
+	case RXSensorsListener:
+		return true
+	case RXChannelSensorsListener:
+		return true
+	case TXSensorsListener:
+		return true
+	case RXNBEnableListener:
+		return true
+	case RXNBParamsListener:
+		return true
+	case RXBinEnableListener:
+		return true
+	case RXNREnableListener:
+		return true
+	case RXANCEnableListener:
+		return true
+	case RXANFEnableListener:
+		return true
+	case RXAPFEnableListener:
+		return true
+	case RXDSEEnableListener:
+		return true
+	case RXNFEnableListener:
+		return true
+	case TXFrequencyListener:
+		return true
+	case AGCModeListener:
+		return true
+	case AGCGainListener:
+		return true
+	case LockListener:
+		return true
+	case VFOLockListener:
+		return true
+	case MonitorEnableListener:
+		return true
+	case MonitorVolumeListener:
+		return true
+	case DIGLOffsetListener:
+		return true
+	case DIGUOffsetListener:
+		return true
+	case TXStreamAudioBufferingListener:
+		return true
+	case ClickedOnSpotListener:
+		return true
+	case RXClickedOnSpotListener:
+		return true
+	default:
+		return false
+	}
+}
+
+// A ProtocolListener is notified when a PROTOCOL message is received from the TCI server.
+type ProtocolListener interface {
+	SetProtocol(name string, version string)
+}
+
+func (n *notifier) emitProtocol(msg Message, listeners []interface{}) error {
+	name, err := msg.ToString(0)
+	if err != nil {
+		return err
+	}
+	version, err := msg.ToString(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(ProtocolListener); ok {
+			listener.SetProtocol(name, version)
+		}
+	}
+	return nil
+}
+
+// A VFOLimitsListener is notified when a VFO_LIMITS message is received from the TCI server.
+type VFOLimitsListener interface {
+	SetVFOLimits(min int, max int)
+}
+
+func (n *notifier) emitVFOLimits(msg Message, listeners []interface{}) error {
+	min, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	max, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(VFOLimitsListener); ok {
+			listener.SetVFOLimits(min, max)
+		}
+	}
+	return nil
+}
+
+// An IFLimitsListener is notified when an IF_LIMITS message is received from the TCI server.
+type IFLimitsListener interface {
+	SetIFLimits(min int, max int)
+}
+
+func (n *notifier) emitIFLimits(msg Message, listeners []interface{}) error {
+	min, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	max, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(IFLimitsListener); ok {
+			listener.SetIFLimits(min, max)
+		}
+	}
+	return nil
+}
+
+// A TRXCountListener is notified when a TRX_COUNT message is received from the TCI server.
+type TRXCountListener interface {
+	SetTRXCount(count int)
+}
+
+func (n *notifier) emitTRXCount(msg Message, listeners []interface{}) error {
+	count, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(TRXCountListener); ok {
+			listener.SetTRXCount(count)
+		}
+	}
+	return nil
+}
+
+// A ChannelCountListener is notified when a CHANNELS_COUNT message is received from the TCI server.
+type ChannelCountListener interface {
+	SetChannelCount(count int)
+}
+
+func (n *notifier) emitChannelCount(msg Message, listeners []interface{}) error {
+	count, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(ChannelCountListener); ok {
+			listener.SetChannelCount(count)
+		}
+	}
+	return nil
+}
+
+// A DeviceNameListener is notified when a DEVICE message is received from the TCI server.
+type DeviceNameListener interface {
+	SetDeviceName(name string)
+}
+
+func (n *notifier) emitDeviceName(msg Message, listeners []interface{}) error {
+	name, err := msg.ToString(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(DeviceNameListener); ok {
+			listener.SetDeviceName(name)
+		}
+	}
+	return nil
+}
+
+// A RXOnlyListener is notified when a RECEIVE_ONLY message is received from the TCI server.
+type RXOnlyListener interface {
+	SetRXOnly(value bool)
+}
+
+func (n *notifier) emitRXOnly(msg Message, listeners []interface{}) error {
+	value, err := msg.ToBool(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXOnlyListener); ok {
+			listener.SetRXOnly(value)
+		}
+	}
+	return nil
+}
+
+// A TXEnableListener is notified when a TX_ENABLE message is received from the TCI server.
+type TXEnableListener interface {
+	SetTXEnable(trx int, enabled bool)
+}
+
+func (n *notifier) emitTXEnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(TXEnableListener); ok {
+			listener.SetTXEnable(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A ReadyListener is notified when a READY message is received from the TCI server.
+type ReadyListener interface {
+	Ready()
+}
+
+func (n *notifier) emitReady(_ Message, listeners []interface{}) error {
+	for _, l := range listeners {
+		if listener, ok := l.(ReadyListener); ok {
+			listener.Ready()
+		}
+	}
+	return nil
+}
+
+// A TXFootswitchListener is notified when a TX_FOOTSWITCH message is received from the TCI server.
+type TXFootswitchListener interface {
+	SetTXFootswitch(trx int, pressed bool)
+}
+
+func (n *notifier) emitTXFootswitch(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	pressed, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(TXFootswitchListener); ok {
+			listener.SetTXFootswitch(trx, pressed)
+		}
+	}
+	return nil
+}
+
+// A StartListener is notified when a START message is received from the TCI server.
+type StartListener interface {
+	Start()
+}
+
+func (n *notifier) emitStart(_ Message, listeners []interface{}) error {
+	for _, l := range listeners {
+		if listener, ok := l.(StartListener); ok {
+			listener.Start()
+		}
+	}
+	return nil
+}
+
+// A StopListener is notified when a STOP message is received from the TCI server.
+type StopListener interface {
+	Stop()
+}
+
+func (n *notifier) emitStop(_ Message, listeners []interface{}) error {
+	for _, l := range listeners {
+		if listener, ok := l.(StopListener); ok {
+			listener.Stop()
+		}
+	}
+	return nil
+}
+
+// A DDSListener is notified when a DDS message is received from the TCI server.
+type DDSListener interface {
+	SetDDS(trx int, frequency int)
+}
+
+func (n *notifier) emitDDS(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	frequency, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(DDSListener); ok {
+			listener.SetDDS(trx, frequency)
+		}
+	}
+	return nil
+}
+
+// An IFListener is notified when an IF message is received from the TCI server.
+type IFListener interface {
+	SetIF(trx int, vfo VFO, frequency int)
+}
+
+func (n *notifier) emitIF(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	vfo, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	frequency, err := msg.ToInt(2)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(IFListener); ok {
+			listener.SetIF(trx, VFO(vfo), frequency)
+		}
+	}
+	return nil
+}
+
+// A RITEnableListener is notified when a RIT_ENABLE message is received from the TCI server.
+type RITEnableListener interface {
+	SetRITEnable(trx int, enabled bool)
+}
+
+func (n *notifier) emitRITEnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RITEnableListener); ok {
+			listener.SetRITEnable(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A ModeListener is notified when a MODULATION message is received from the TCI server.
+type ModeListener interface {
+	SetMode(trx int, mode Mode)
+}
+
+func (n *notifier) emitMode(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	mode, err := msg.toMode(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(ModeListener); ok {
+			listener.SetMode(trx, mode)
+		}
+	}
+	return nil
+}
+
+// A RXEnableListener is notified when a RX_ENABLE message is received from the TCI server.
+type RXEnableListener interface {
+	SetRXEnable(trx int, enabled bool)
+}
+
+func (n *notifier) emitRXEnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXEnableListener); ok {
+			listener.SetRXEnable(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A XITEnableListener is notified when a XIT_ENABLE message is received from the TCI server.
+type XITEnableListener interface {
+	SetXITEnable(trx int, enabled bool)
+}
+
+func (n *notifier) emitXITEnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(XITEnableListener); ok {
+			listener.SetXITEnable(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A SplitEnableListener is notified when a SPLIT_ENABLE message is received from the TCI server.
+type SplitEnableListener interface {
+	SetSplitEnable(trx int, enabled bool)
+}
+
+func (n *notifier) emitSplitEnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(SplitEnableListener); ok {
+			listener.SetSplitEnable(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A RITOffsetListener is notified when a RIT_OFFSET message is received from the TCI server.
+type RITOffsetListener interface {
+	SetRITOffset(trx int, offset int)
+}
+
+func (n *notifier) emitRITOffset(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	offset, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RITOffsetListener); ok {
+			listener.SetRITOffset(trx, offset)
+		}
+	}
+	return nil
+}
+
+// A XITOffsetListener is notified when a XIT_OFFSET message is received from the TCI server.
+type XITOffsetListener interface {
+	SetXITOffset(trx int, offset int)
+}
+
+func (n *notifier) emitXITOffset(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	offset, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(XITOffsetListener); ok {
+			listener.SetXITOffset(trx, offset)
+		}
+	}
+	return nil
+}
+
+// A RXChannelEnableListener is notified when a RX_CHANNEL_ENABLE message is received from the TCI server.
+type RXChannelEnableListener interface {
+	SetRXChannelEnable(trx int, vfo VFO, enabled bool)
+}
+
+func (n *notifier) emitRXChannelEnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	vfo, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(2)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXChannelEnableListener); ok {
+			listener.SetRXChannelEnable(trx, VFO(vfo), enabled)
+		}
+	}
+	return nil
+}
+
+// A RXFilterBandListener is notified when a RX_FILTER_BAND message is received from the TCI server.
+type RXFilterBandListener interface {
+	SetRXFilterBand(trx int, min int, max int)
+}
+
+func (n *notifier) emitRXFilterBand(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	min, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	max, err := msg.ToInt(2)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXFilterBandListener); ok {
+			listener.SetRXFilterBand(trx, min, max)
+		}
+	}
+	return nil
+}
+
+// A RXSMeterListener is notified when a RX_SMETER message is received from the TCI server.
+type RXSMeterListener interface {
+	SetRXSMeter(trx int, vfo VFO, level int)
+}
+
+func (n *notifier) emitRXSMeter(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	vfo, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	level, err := msg.ToInt(2)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXSMeterListener); ok {
+			listener.SetRXSMeter(trx, VFO(vfo), level)
+		}
+	}
+	return nil
+}
+
+// A CWMacrosSpeedListener is notified when a CW_MACROS_SPEED message is received from the TCI server.
+type CWMacrosSpeedListener interface {
+	SetCWMacrosSpeed(wpm int)
+}
+
+func (n *notifier) emitCWMacrosSpeed(msg Message, listeners []interface{}) error {
+	wpm, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(CWMacrosSpeedListener); ok {
+			listener.SetCWMacrosSpeed(wpm)
+		}
+	}
+	return nil
+}
+
+// A CWMacrosDelayListener is notified when a CW_MACROS_DELAY message is received from the TCI server.
+type CWMacrosDelayListener interface {
+	SetCWMacrosDelay(delay int)
+}
+
+func (n *notifier) emitCWMacrosDelay(msg Message, listeners []interface{}) error {
+	delay, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(CWMacrosDelayListener); ok {
+			listener.SetCWMacrosDelay(delay)
+		}
+	}
+	return nil
+}
+
+// A CWMacrosEmptyListener is notified when a CW_MACROS_EMPTY message is received from the TCI server. (since TCI 1.5)
+type CWMacrosEmptyListener interface {
+	CWMacrosEmpty()
+}
+
+func (n *notifier) emitCWMacrosEmpty(_ Message, listeners []interface{}) error {
+	for _, l := range listeners {
+		if listener, ok := l.(CWMacrosEmptyListener); ok {
+			listener.CWMacrosEmpty()
+		}
+	}
+	return nil
+}
+
+// A TXListener is notified when a TRX message is received from the TCI server.
+type TXListener interface {
+	SetTX(trx int, enabled bool)
+}
+
+func (n *notifier) emitTX(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(TXListener); ok {
+			listener.SetTX(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A TuneListener is notified when a TUNE message is received from the TCI server.
+type TuneListener interface {
+	SetTune(trx int, enabled bool)
+}
+
+func (n *notifier) emitTune(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(TuneListener); ok {
+			listener.SetTune(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A StartIQListener is notified when an IQ_START message is received from the TCI server.
+type StartIQListener interface {
+	StartIQ(trx int)
+}
+
+func (n *notifier) emitStartIQ(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(StartIQListener); ok {
+			listener.StartIQ(trx)
+		}
+	}
+	return nil
+}
+
+// A StopIQListener is notified when an IQ_STOP message is received from the TCI server.
+type StopIQListener interface {
+	StopIQ(trx int)
+}
+
+func (n *notifier) emitStopIQ(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(StopIQListener); ok {
+			listener.StopIQ(trx)
+		}
+	}
+	return nil
+}
+
+// An IQSampleRateListener is notified when an IQ_SAMPLERATE message is received from the TCI server.
+type IQSampleRateListener interface {
+	SetIQSampleRate(sampleRate IQSampleRate)
+}
+
+func (n *notifier) emitIQSampleRate(msg Message, listeners []interface{}) error {
+	sampleRate, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(IQSampleRateListener); ok {
+			listener.SetIQSampleRate(IQSampleRate(sampleRate))
+		}
+	}
+	return nil
+}
+
+// A StartAudioListener is notified when an AUDIO_START message is received from the TCI server.
+type StartAudioListener interface {
+	StartAudio(trx int)
+}
+
+func (n *notifier) emitStartAudio(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(StartAudioListener); ok {
+			listener.StartAudio(trx)
+		}
+	}
+	return nil
+}
+
+// A StopAudioListener is notified when an AUDIO_STOP message is received from the TCI server.
+type StopAudioListener interface {
+	StopAudio(trx int)
+}
+
+func (n *notifier) emitStopAudio(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(StopAudioListener); ok {
+			listener.StopAudio(trx)
+		}
+	}
+	return nil
+}
+
+// An AudioSampleRateListener is notified when an AUDIO_SAMPLERATE message is received from the TCI server.
+type AudioSampleRateListener interface {
+	SetAudioSampleRate(sampleRate AudioSampleRate)
+}
+
+func (n *notifier) emitAudioSampleRate(msg Message, listeners []interface{}) error {
+	sampleRate, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(AudioSampleRateListener); ok {
+			listener.SetAudioSampleRate(AudioSampleRate(sampleRate))
+		}
+	}
+	return nil
+}
+
+// An AudioStreamSampleTypeListener is notified when an AUDIO_STREAM_SAMPLE_TYPE message is received from the TCI server. (since TCI 1.6)
+type AudioStreamSampleTypeListener interface {
+	SetAudioStreamSampleType(sampleType SampleType)
+}
+
+func (n *notifier) emitAudioStreamSampleType(msg Message, listeners []interface{}) error {
+	sampleType, err := msg.toSampleType(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(AudioStreamSampleTypeListener); ok {
+			listener.SetAudioStreamSampleType(sampleType)
+		}
+	}
+	return nil
+}
+
+// An AudioStreamChannelsListener is notified when an AUDIO_STREAM_CHANNELS message is received from the TCI server. (since TCI 1.6)
+type AudioStreamChannelsListener interface {
+	SetAudioStreamChannels(count int)
+}
+
+func (n *notifier) emitAudioStreamChannels(msg Message, listeners []interface{}) error {
+	count, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(AudioStreamChannelsListener); ok {
+			listener.SetAudioStreamChannels(count)
+		}
+	}
+	return nil
+}
+
+// A TXPowerListener is notified when a TX_POWER message is received from the TCI server.
+type TXPowerListener interface {
+	SetTXPower(watts float64)
+}
+
+func (n *notifier) emitTXPower(msg Message, listeners []interface{}) error {
+	watts, err := msg.ToFloat(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(TXPowerListener); ok {
+			listener.SetTXPower(watts)
+		}
+	}
+	return nil
+}
+
+// A TXSWRListener is notified when a TX_SWR message is received from the TCI server.
+type TXSWRListener interface {
+	SetTXSWR(ratio float64)
+}
+
+func (n *notifier) emitTXSWR(msg Message, listeners []interface{}) error {
+	ratio, err := msg.ToFloat(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(TXSWRListener); ok {
+			listener.SetTXSWR(ratio)
+		}
+	}
+	return nil
+}
+
+// A VolumeListener is notified when a VOLUME message is received from the TCI server.
+type VolumeListener interface {
+	SetVolume(dB int)
+}
+
+func (n *notifier) emitVolume(msg Message, listeners []interface{}) error {
+	dB, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(VolumeListener); ok {
+			listener.SetVolume(dB)
+		}
+	}
+	return nil
+}
+
+// A SquelchEnableListener is notified when a SQL_ENABLE message is received from the TCI server.
+type SquelchEnableListener interface {
+	SetSquelchEnable(trx int, enabled bool)
+}
+
+func (n *notifier) emitSquelchEnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(SquelchEnableListener); ok {
+			listener.SetSquelchEnable(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A SquelchLevelListener is notified when a SQL_LEVEL message is received from the TCI server.
+type SquelchLevelListener interface {
+	SetSquelchLevel(dB int)
+}
+
+func (n *notifier) emitSquelchLevel(msg Message, listeners []interface{}) error {
+	dB, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(SquelchLevelListener); ok {
+			listener.SetSquelchLevel(dB)
+		}
+	}
+	return nil
+}
+
+// A VFOFrequencyListener is notified when a VFO message is received from the TCI server.
+type VFOFrequencyListener interface {
+	SetVFOFrequency(trx int, vfo VFO, frequency int)
+}
+
+func (n *notifier) emitVFOFrequency(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	vfo, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	frequency, err := msg.ToInt(2)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(VFOFrequencyListener); ok {
+			listener.SetVFOFrequency(trx, VFO(vfo), frequency)
+		}
+	}
+	return nil
+}
+
+// An AppFocusListener is notified when an APP_FOCUS message is received from the TCI server.
+type AppFocusListener interface {
+	SetAppFocus(focussed bool)
+}
+
+func (n *notifier) emitAppFocus(msg Message, listeners []interface{}) error {
+	focussed, err := msg.ToBool(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(AppFocusListener); ok {
+			listener.SetAppFocus(focussed)
+		}
+	}
+	return nil
+}
+
+// A MuteListener is notified when a MUTE message is received from the TCI server.
+type MuteListener interface {
+	SetMute(muted bool)
+}
+
+func (n *notifier) emitMute(msg Message, listeners []interface{}) error {
+	muted, err := msg.ToBool(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(MuteListener); ok {
+			listener.SetMute(muted)
+		}
+	}
+	return nil
+}
+
+// A RXMuteListener is notified when a RX_MUTE message is received from the TCI server.
+type RXMuteListener interface {
+	SetRXMute(trx int, muted bool)
+}
+
+func (n *notifier) emitRXMute(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	muted, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXMuteListener); ok {
+			listener.SetRXMute(trx, muted)
+		}
+	}
+	return nil
+}
+
+// A CTCSSEnableListener is notified when a CTCSS_ENABLE message is received from the TCI server.
+type CTCSSEnableListener interface {
+	SetCTCSSEnable(trx int, enabled bool)
+}
+
+func (n *notifier) emitCTCSSEnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(CTCSSEnableListener); ok {
+			listener.SetCTCSSEnable(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A CTCSSModeListener is notified when a CTCSS_MODE message is received from the TCI server.
+type CTCSSModeListener interface {
+	SetCTCSSMode(trx int, mode CTCSSMode)
+}
+
+func (n *notifier) emitCTCSSMode(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	mode, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(CTCSSModeListener); ok {
+			listener.SetCTCSSMode(trx, CTCSSMode(mode))
+		}
+	}
+	return nil
+}
+
+// A CTCSSRXToneListener is notified when a CTCSS_RX_TONE message is received from the TCI server.
+type CTCSSRXToneListener interface {
+	SetCTCSSRXTone(trx int, tone CTCSSTone)
+}
+
+func (n *notifier) emitCTCSSRXTone(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	tone, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(CTCSSRXToneListener); ok {
+			listener.SetCTCSSRXTone(trx, CTCSSTone(tone))
+		}
+	}
+	return nil
+}
+
+// A CTCSSTXToneListener is notified when a CTCSS_TX_TONE message is received from the TCI server.
+type CTCSSTXToneListener interface {
+	SetCTCSSTXTone(trx int, tone CTCSSTone)
+}
+
+func (n *notifier) emitCTCSSTXTone(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	tone, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(CTCSSTXToneListener); ok {
+			listener.SetCTCSSTXTone(trx, CTCSSTone(tone))
+		}
+	}
+	return nil
+}
+
+// A CTCSSLevelListener is notified when a CTCSS_LEVEL message is received from the TCI server.
+type CTCSSLevelListener interface {
+	SetCTCSSLevel(trx int, percent int)
+}
+
+func (n *notifier) emitCTCSSLevel(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	percent, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(CTCSSLevelListener); ok {
+			listener.SetCTCSSLevel(trx, percent)
+		}
+	}
+	return nil
+}
+
+// An ECoderSwitchRXListener is notified when an ECODER_SWITCH_RX message is received from the TCI server.
+type ECoderSwitchRXListener interface {
+	SetECoderSwitchRX(ecoder int, trx int)
+}
+
+func (n *notifier) emitECoderSwitchRX(msg Message, listeners []interface{}) error {
+	ecoder, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	trx, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(ECoderSwitchRXListener); ok {
+			listener.SetECoderSwitchRX(ecoder, trx)
+		}
+	}
+	return nil
+}
+
+// An ECoderSwitchChannelListener is notified when an ECODER_SWITCH_CHANNEL message is received from the TCI server.
+type ECoderSwitchChannelListener interface {
+	SetECoderSwitchChannel(ecoder int, vfo VFO)
+}
+
+func (n *notifier) emitECoderSwitchChannel(msg Message, listeners []interface{}) error {
+	ecoder, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	vfo, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(ECoderSwitchChannelListener); ok {
+			listener.SetECoderSwitchChannel(ecoder, VFO(vfo))
+		}
+	}
+	return nil
+}
+
+// A RXVolumeListener is notified when a RX_VOLUME message is received from the TCI server.
+type RXVolumeListener interface {
+	SetRXVolume(trx int, vfo VFO, dB int)
+}
+
+func (n *notifier) emitRXVolume(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	vfo, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	dB, err := msg.ToInt(2)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXVolumeListener); ok {
+			listener.SetRXVolume(trx, VFO(vfo), dB)
+		}
+	}
+	return nil
+}
+
+// A RXBalanceListener is notified when a RX_BALANCE message is received from the TCI server.
+type RXBalanceListener interface {
+	SetRXBalance(trx int, vfo VFO, dB int)
+}
+
+func (n *notifier) emitRXBalance(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	vfo, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	dB, err := msg.ToInt(2)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXBalanceListener); ok {
+			listener.SetRXBalance(trx, VFO(vfo), dB)
+		}
+	}
+	return nil
+}
+
+// A RXSensorsListener is notified when a RX_SENSORS message is received from the TCI server. (since TCI 1.5)
+type RXSensorsListener interface {
+	SetRXSensors(trx int, dBm float64)
+}
+
+func (n *notifier) emitRXSensors(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	dBm, err := msg.ToFloat(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXSensorsListener); ok {
+			listener.SetRXSensors(trx, dBm)
+		}
+	}
+	return nil
+}
+
+// A RXChannelSensorsListener is notified when a RX_CHANNEL_SENSORS message is received from the TCI server. (since TCI 1.9, unverified)
+type RXChannelSensorsListener interface {
+	SetRXChannelSensors(trx int, vfo VFO, dBm float64)
+}
+
+func (n *notifier) emitRXChannelSensors(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	vfo, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	dBm, err := msg.ToFloat(2)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXChannelSensorsListener); ok {
+			listener.SetRXChannelSensors(trx, VFO(vfo), dBm)
+		}
+	}
+	return nil
+}
+
+// A TXSensorsListener is notified when a TX_SENSORS message is received from the TCI server. (since TCI 1.5)
+type TXSensorsListener interface {
+	SetTXSensors(trx int, micdBm float64, txRMS float64, txPeak float64, swr float64)
+}
+
+func (n *notifier) emitTXSensors(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	micdBm, err := msg.ToFloat(1)
+	if err != nil {
+		return err
+	}
+	txRMS, err := msg.ToFloat(2)
+	if err != nil {
+		return err
+	}
+	txPeak, err := msg.ToFloat(3)
+	if err != nil {
+		return err
+	}
+	swr, err := msg.ToFloat(4)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(TXSensorsListener); ok {
+			listener.SetTXSensors(trx, micdBm, txRMS, txPeak, swr)
+		}
+	}
+	return nil
+}
+
+// A RXNBEnableListener is notified when a RX_NB_ENABLE message is received from the TCI server. (since TCI 1.6)
+type RXNBEnableListener interface {
+	SetRXNBEnable(trx int, enabled bool)
+}
+
+func (n *notifier) emitRXNBEnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXNBEnableListener); ok {
+			listener.SetRXNBEnable(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A RXNBParamsListener is notified when a RX_NB_PARAM message is received from the TCI server. (since TCI 1.6)
+type RXNBParamsListener interface {
+	SetRXNBParams(trx int, threshold int, impulseLength int)
+}
+
+func (n *notifier) emitRXNBParams(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	threshold, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	impulseLength, err := msg.ToInt(2)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXNBParamsListener); ok {
+			listener.SetRXNBParams(trx, threshold, impulseLength)
+		}
+	}
+	return nil
+}
+
+// A RXBinEnableListener is notified when a RX_BIN_ENABLE message is received from the TCI server. (since TCI 1.6)
+type RXBinEnableListener interface {
+	SetRXBinEnable(trx int, enabled bool)
+}
+
+func (n *notifier) emitRXBinEnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXBinEnableListener); ok {
+			listener.SetRXBinEnable(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A RXNREnableListener is notified when a RX_NR_ENABLE message is received from the TCI server. (since TCI 1.6)
+type RXNREnableListener interface {
+	SetRXNREnable(trx int, enabled bool)
+}
+
+func (n *notifier) emitRXNREnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXNREnableListener); ok {
+			listener.SetRXNREnable(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A RXANCEnableListener is notified when a RX_ANC_ENABLE message is received from the TCI server. (since TCI 1.6)
+type RXANCEnableListener interface {
+	SetRXANCEnable(trx int, enabled bool)
+}
+
+func (n *notifier) emitRXANCEnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXANCEnableListener); ok {
+			listener.SetRXANCEnable(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A RXANFEnableListener is notified when a RX_ANF_ENABLE message is received from the TCI server. (since TCI 1.6)
+type RXANFEnableListener interface {
+	SetRXANFEnable(trx int, enabled bool)
+}
+
+func (n *notifier) emitRXANFEnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXANFEnableListener); ok {
+			listener.SetRXANFEnable(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A RXAPFEnableListener is notified when a RX_APF_ENABLE message is received from the TCI server. (since TCI 1.6)
+type RXAPFEnableListener interface {
+	SetRXAPFEnable(trx int, enabled bool)
+}
+
+func (n *notifier) emitRXAPFEnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXAPFEnableListener); ok {
+			listener.SetRXAPFEnable(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A RXDSEEnableListener is notified when a RX_DSE_ENABLE message is received from the TCI server. (since TCI 1.6)
+type RXDSEEnableListener interface {
+	SetRXDSEEnable(trx int, enabled bool)
+}
+
+func (n *notifier) emitRXDSEEnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXDSEEnableListener); ok {
+			listener.SetRXDSEEnable(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A RXNFEnableListener is notified when a RX_NF_ENABLE message is received from the TCI server. (since TCI 1.6)
+type RXNFEnableListener interface {
+	SetRXNFEnable(trx int, enabled bool)
+}
+
+func (n *notifier) emitRXNFEnable(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXNFEnableListener); ok {
+			listener.SetRXNFEnable(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A TXFrequencyListener is notified when a TX_FREQUENCY message is received from the TCI server. (since TCI 1.6)
+type TXFrequencyListener interface {
+	SetTXFrequency(trx int, frequency int)
+}
+
+func (n *notifier) emitTXFrequency(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	frequency, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(TXFrequencyListener); ok {
+			listener.SetTXFrequency(trx, frequency)
+		}
+	}
+	return nil
+}
+
+// An AGCModeListener is notified when an AGC_MODE message is received from the TCI server. (since TCI 1.8, unverified)
+type AGCModeListener interface {
+	SetAGCMode(trx int, mode AGCMode)
+}
+
+func (n *notifier) emitAGCMode(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	mode, err := msg.toAGCMode(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(AGCModeListener); ok {
+			listener.SetAGCMode(trx, mode)
+		}
+	}
+	return nil
+}
+
+// An AGCGainListener is notified when an AGC_GAIN message is received from the TCI server. (since TCI 1.8, unverified)
+type AGCGainListener interface {
+	SetAGCGain(trx int, dB int)
+}
+
+func (n *notifier) emitAGCGain(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	dB, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(AGCGainListener); ok {
+			listener.SetAGCGain(trx, dB)
+		}
+	}
+	return nil
+}
+
+// A LockListener is notified when a LOCK message is received from the TCI server. (since TCI 1.8, unverified)
+type LockListener interface {
+	SetLock(trx int, enabled bool)
+}
+
+func (n *notifier) emitLock(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(LockListener); ok {
+			listener.SetLock(trx, enabled)
+		}
+	}
+	return nil
+}
+
+// A VFOLockListener is notified when a VFO_LOCK message is received from the TCI server. (since TCI 1.9, unverified)
+type VFOLockListener interface {
+	SetVFOLock(trx int, vfo VFO, enabled bool)
+}
+
+func (n *notifier) emitVFOLock(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	vfo, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	enabled, err := msg.ToBool(2)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(VFOLockListener); ok {
+			listener.SetVFOLock(trx, VFO(vfo), enabled)
+		}
+	}
+	return nil
+}
+
+// A MonitorEnableListener is notified when a MON_ENABLE message is received from the TCI server. (since TCI 1.8, unverified)
+type MonitorEnableListener interface {
+	SetMonitorEnable(enabled bool)
+}
+
+func (n *notifier) emitMonitorEnable(msg Message, listeners []interface{}) error {
+	enabled, err := msg.ToBool(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(MonitorEnableListener); ok {
+			listener.SetMonitorEnable(enabled)
+		}
+	}
+	return nil
+}
+
+// A MonitorVolumeListener is notified when a MON_VOLUME message is received from the TCI server. (since TCI 1.8, unverified)
+type MonitorVolumeListener interface {
+	SetMonitorVolume(dB int)
+}
+
+func (n *notifier) emitMonitorVolume(msg Message, listeners []interface{}) error {
+	dB, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(MonitorVolumeListener); ok {
+			listener.SetMonitorVolume(dB)
+		}
+	}
+	return nil
+}
+
+// A DIGLOffsetListener is notified when a DIGL_OFFSET message is received from the TCI server. (since TCI 1.8, unverified)
+type DIGLOffsetListener interface {
+	SetDIGLOffset(offset int)
+}
+
+func (n *notifier) emitDIGLOffset(msg Message, listeners []interface{}) error {
+	offset, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(DIGLOffsetListener); ok {
+			listener.SetDIGLOffset(offset)
+		}
+	}
+	return nil
+}
+
+// A DIGUOffsetListener is notified when a DIGU_OFFSET message is received from the TCI server. (since TCI 1.8, unverified)
+type DIGUOffsetListener interface {
+	SetDIGUOffset(offset int)
+}
+
+func (n *notifier) emitDIGUOffset(msg Message, listeners []interface{}) error {
+	offset, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(DIGUOffsetListener); ok {
+			listener.SetDIGUOffset(offset)
+		}
+	}
+	return nil
+}
+
+// A TXStreamAudioBufferingListener is notified when a TX_STREAM_AUDIO_BUFFERING message is received from the TCI server. (since TCI 1.9, unverified)
+type TXStreamAudioBufferingListener interface {
+	SetTXStreamAudioBuffering(milliseconds int)
+}
+
+func (n *notifier) emitTXStreamAudioBuffering(msg Message, listeners []interface{}) error {
+	milliseconds, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(TXStreamAudioBufferingListener); ok {
+			listener.SetTXStreamAudioBuffering(milliseconds)
+		}
+	}
+	return nil
+}
+
+// A ClickedOnSpotListener is notified when a CLICKED_ON_SPOT message is received from the TCI server.
+type ClickedOnSpotListener interface {
+	ClickedOnSpot(callsign string, frequency int)
+}
+
+func (n *notifier) emitClickedOnSpot(msg Message, listeners []interface{}) error {
+	callsign, err := msg.ToString(0)
+	if err != nil {
+		return err
+	}
+	frequency, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(ClickedOnSpotListener); ok {
+			listener.ClickedOnSpot(callsign, frequency)
+		}
+	}
+	return nil
+}
+
+// A RXClickedOnSpotListener is notified when a RX_CLICKED_ON_SPOT message is received from the TCI server. (since TCI 1.6)
+type RXClickedOnSpotListener interface {
+	RXClickedOnSpot(trx int, vfo VFO, callsign string, frequency int)
+}
+
+func (n *notifier) emitRXClickedOnSpot(msg Message, listeners []interface{}) error {
+	trx, err := msg.ToInt(0)
+	if err != nil {
+		return err
+	}
+	vfo, err := msg.ToInt(1)
+	if err != nil {
+		return err
+	}
+	callsign, err := msg.ToString(2)
+	if err != nil {
+		return err
+	}
+	frequency, err := msg.ToInt(3)
+	if err != nil {
+		return err
+	}
+	for _, l := range listeners {
+		if listener, ok := l.(RXClickedOnSpotListener); ok {
+			listener.RXClickedOnSpot(trx, VFO(vfo), callsign, frequency)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package client
+
+import (
+	"fmt"
+	"log"
+	"strings"
+)
+
+// Logger is used by the Client to log connection events, errors, and the traced TCI communication.
+// The args are alternating keys and values, like with log/slog. A *slog.Logger implements this interface.
+type Logger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+}
+
+// LogLevel is the minimum level of the messages that are written by a logger created with NewStdLogger.
+type LogLevel int
+
+// All log levels, their values are compatible with log/slog.
+const (
+	LogLevelDebug LogLevel = -4
+	LogLevelInfo  LogLevel = 0
+	LogLevelWarn  LogLevel = 4
+	LogLevelError LogLevel = 8
+)
+
+func (l LogLevel) String() string {
+	switch {
+	case l < LogLevelInfo:
+		return "DEBUG"
+	case l < LogLevelWarn:
+		return "INFO"
+	case l < LogLevelError:
+		return "WARN"
+	default:
+		return "ERROR"
+	}
+}
+
+// NewStdLogger returns a Logger that writes all messages with at least the given level to the given *log.Logger.
+// If l is nil, the standard logger of the log package is used.
+// The key-value pairs are appended to the message as key=value.
+func NewStdLogger(l *log.Logger, level LogLevel) Logger {
+	if l == nil {
+		l = log.Default()
+	}
+	return &stdLogger{logger: l, level: level}
+}
+
+type stdLogger struct {
+	logger *log.Logger
+	level  LogLevel
+}
+
+func (l *stdLogger) Debug(msg string, args ...any) { l.log(LogLevelDebug, msg, args) }
+func (l *stdLogger) Info(msg string, args ...any)  { l.log(LogLevelInfo, msg, args) }
+func (l *stdLogger) Warn(msg string, args ...any)  { l.log(LogLevelWarn, msg, args) }
+func (l *stdLogger) Error(msg string, args ...any) { l.log(LogLevelError, msg, args) }
+
+func (l *stdLogger) log(level LogLevel, msg string, args []any) {
+	if level < l.level {
+		return
+	}
+	var b strings.Builder
+	b.WriteString(level.String())
+	b.WriteString(" ")
+	b.WriteString(msg)
+	for i := 0; i < len(args); i += 2 {
+		if i+1 < len(args) {
+			fmt.Fprintf(&b, " %v=%v", args[i], args[i+1])
+		} else {
+			fmt.Fprintf(&b, " !BADKEY=%v", args[i])
+		}
+	}
+	l.logger.Print(b.String())
+}
+
+var (
+	// defaultLogger is used if no other logger is configured with WithLogger.
+	defaultLogger = NewStdLogger(nil, LogLevelInfo)
+	// defaultTraceLogger is used instead of the defaultLogger if the tracing is enabled.
+	defaultTraceLogger = NewStdLogger(nil, LogLevelDebug)
+)
+
+// NopLogger discards all messages.
+var NopLogger Logger = nopLogger{}
+
+type nopLogger struct{}
+
+func (nopLogger) Debug(string, ...any) {}
+func (nopLogger) Info(string, ...any)  {}
+func (nopLogger) Warn(string, ...any)  {}
+func (nopLogger) Error(string, ...any) {}
@@ -0,0 +1,116 @@
+package client_test
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ftl/tci/client"
+	"github.com/ftl/tci/client/clienttest"
+)
+
+func TestStdLogger(t *testing.T) {
+	buffer := new(bytes.Buffer)
+	logger := client.NewStdLogger(log.New(buffer, "", 0), client.LogLevelInfo)
+
+	logger.Debug("hidden")
+	logger.Info("connected", "host", "ws://localhost:40001")
+	logger.Warn("odd", "key")
+
+	assert.Equal(t, "INFO connected host=ws://localhost:40001\nWARN odd !BADKEY=key\n", buffer.String())
+}
+
+type recordingLogger struct {
+	lock     sync.Mutex
+	messages []string
+}
+
+func (l *recordingLogger) record(level, msg string, args []any) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	l.messages = append(l.messages, fmt.Sprintf("%s %s %v", level, msg, args))
+}
+
+func (l *recordingLogger) contains(msg string) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+	for _, m := range l.messages {
+		if m == msg {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *recordingLogger) Debug(msg string, args ...any) { l.record("DEBUG", msg, args) }
+func (l *recordingLogger) Info(msg string, args ...any)  { l.record("INFO", msg, args) }
+func (l *recordingLogger) Warn(msg string, args ...any)  { l.record("WARN", msg, args) }
+func (l *recordingLogger) Error(msg string, args ...any) { l.record("ERROR", msg, args) }
+
+func TestWithLogger(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	logger := &recordingLogger{}
+
+	c, err := client.OpenURL(server.URL(), client.WithLogger(logger), client.WithTrace(true))
+	require.NoError(t, err)
+	defer c.Disconnect()
+
+	require.NoError(t, c.SetVolume(-10))
+	require.NoError(t, server.Send(client.NewCommandMessage("unknown_message", 1)))
+
+	assert.True(t, logger.contains(fmt.Sprintf("INFO connected [host %s remote_addr %s]", server.URL(), server.Addr())))
+	assert.True(t, logger.contains(fmt.Sprintf("DEBUG > [host %s message volume:-10;]", server.URL())))
+	assert.Eventually(t, func() bool {
+		return logger.contains("INFO unknown incoming message [name unknown_message message unknown_message:1;]")
+	}, time.Second, time.Millisecond)
+}
+
+func TestWithUnknownMessageLogging(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	logger := &recordingLogger{}
+	volume := make(chan int, 10)
+
+	c, err := client.OpenURL(server.URL(),
+		client.WithLogger(logger),
+		client.WithUnknownMessageLogging(false),
+		client.WithListeners(client.VolumeListenerFunc(func(dB int) { volume <- dB })),
+	)
+	require.NoError(t, err)
+	defer c.Disconnect()
+
+	require.NoError(t, server.Send(client.NewCommandMessage("unknown_message", 1)))
+	require.NoError(t, server.Send(client.NewCommandMessage("volume", -20)))
+	for dB := range volume {
+		if dB == -20 {
+			break
+		}
+	}
+
+	assert.False(t, logger.contains("INFO unknown incoming message [name unknown_message message unknown_message:1;]"))
+}
+
+type noListener struct{}
+
+func TestSubscribe_WarnsAboutInvalidListeners(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	logger := &recordingLogger{}
+
+	c, err := client.OpenURL(server.URL(), client.WithListeners(noListener{}), client.WithLogger(logger))
+	require.NoError(t, err)
+	defer c.Disconnect()
+
+	assert.True(t, logger.contains("WARN listener implements no listener interface [type client_test.noListener]"))
+
+	unsubscribe := c.Subscribe(func(dB int) {})
+	unsubscribe()
+	assert.True(t, logger.contains("WARN listener implements no listener interface [type func(int)]"))
+}
@@ -3,44 +3,40 @@ package client
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"fmt"
-	"regexp"
+	"io"
+	"math"
+	"reflect"
 	"strconv"
 	"strings"
 )
 
-var messageExp = regexp.MustCompile(`(?P<name>[A-Za-z_]+)(:(?P<args>[A-Za-z0-9-.]+(,[A-Za-z0-9-.]+)*))?;`)
-
-// ParseMessage interprets the given string as a TCI message.
+// ParseTextMessage interprets the given string as a single TCI message.
 func ParseTextMessage(s string) (Message, error) {
-	matches := messageExp.FindStringSubmatch(s)
-	if len(matches) == 0 {
-		return Message{}, fmt.Errorf("invalid message format: %s", s)
+	tokenizer := NewTokenizer(strings.NewReader(s))
+	result, err := tokenizer.Next()
+	if err == io.EOF {
+		return Message{}, &ParseError{Offset: 0, Reason: "empty message", Err: io.ErrUnexpectedEOF}
 	}
-
-	nameIndex := messageExp.SubexpIndex("name")
-	if nameIndex == -1 {
-		return Message{}, fmt.Errorf("invalid message format, name not found: %s", s)
+	if err != nil {
+		return Message{}, err
 	}
-	name := strings.ToLower(strings.TrimSpace(matches[nameIndex]))
-
-	argsIndex := messageExp.SubexpIndex("args")
-	var args []string
-	if argsIndex == -1 || matches[argsIndex] == "" {
-		args = []string{}
-	} else {
-		args = strings.Split(matches[argsIndex], ",")
+	err = tokenizer.skipWhitespace()
+	if err != io.EOF {
+		return Message{}, &ParseError{Offset: tokenizer.offset, Reason: "more than one message"}
 	}
-
-	return Message{name: name, args: args}, nil
+	return result, nil
 }
 
 // NewCommandMessage returns a new message with the given name and the given arguments that does not require a response.
+// The arguments are encoded with their TCI representation, see Err for arguments that cannot be represented.
 func NewCommandMessage(name string, args ...interface{}) Message {
 	return newMessage(name, false, args)
 }
 
 // NewRequestMessage returns a new message with the given name and the given arguments that requires a response.
+// The arguments are encoded with their TCI representation, see Err for arguments that cannot be represented.
 func NewRequestMessage(name string, args ...interface{}) Message {
 	return newMessage(name, true, args)
 }
@@ -51,31 +47,126 @@ func newMessage(name string, responseRequired bool, args []interface{}) Message
 		args:             make([]string, len(args)),
 		responseRequired: responseRequired,
 	}
+	if err := validateName(result.name); err != nil {
+		result.err = &InvalidArgumentError{Command: result.name, Index: -1, Value: name, Reason: err.Error()}
+	}
 	for i, arg := range args {
-		result.args[i] = strings.TrimSpace(fmt.Sprintf("%v", arg))
+		encoded, err := encodeArgument(arg)
+		if err != nil && result.err == nil {
+			result.err = &InvalidArgumentError{Command: result.name, Index: i, Value: arg, Reason: err.Error()}
+		}
+		result.args[i] = encoded
 	}
 	return result
 }
 
+// encodeArgument returns the TCI representation of the given argument value. The reserved characters (:,;) within
+// strings are escaped later, when the message is written, see Message.String.
+func encodeArgument(arg interface{}) (string, error) {
+	switch value := arg.(type) {
+	case string:
+		return value, validateText(value)
+	case fmt.Stringer:
+		text := value.String()
+		return text, validateText(text)
+	case bool:
+		return strconv.FormatBool(value), nil
+	case float32:
+		return encodeFloat(float64(value), 32)
+	case float64:
+		return encodeFloat(value, 64)
+	}
+
+	v := reflect.ValueOf(arg)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10), nil
+	case reflect.Float32:
+		return encodeFloat(v.Float(), 32)
+	case reflect.Float64:
+		return encodeFloat(v.Float(), 64)
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), nil
+	case reflect.String:
+		return v.String(), validateText(v.String())
+	default:
+		return fmt.Sprintf("%v", arg), fmt.Errorf("unsupported argument type %T", arg)
+	}
+}
+
+func encodeFloat(value float64, bitSize int) (string, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) {
+		return "", fmt.Errorf("%v cannot be represented", value)
+	}
+	return strconv.FormatFloat(value, 'f', -1, bitSize), nil
+}
+
+// validateText checks if the given text can be represented as TCI argument. The escape characters
+// (^~*) cannot be represented, because they are interpreted as the reserved characters (:,;) by the receiver.
+func validateText(text string) error {
+	for i := 0; i < len(text); i++ {
+		b := text[i]
+		if _, ok := unescapes[b]; ok {
+			return fmt.Errorf("the character %q cannot be represented", b)
+		}
+		if b < ' ' || b == 0x7f {
+			return fmt.Errorf("the control character %q cannot be represented", b)
+		}
+	}
+	return nil
+}
+
+func validateName(name string) error {
+	if name == "" {
+		return errors.New("missing message name")
+	}
+	for i := 0; i < len(name); i++ {
+		if !isNameCharacter(name[i]) {
+			return fmt.Errorf("invalid character %q in message name", name[i])
+		}
+	}
+	return nil
+}
+
 // Message represents a message that is exchanged between the TCI server and a client.
 type Message struct {
 	name             string
 	args             []string
 	responseRequired bool
+	err              error
+}
+
+// Err returns an *InvalidArgumentError if the name or an argument of this message cannot be represented in the TCI protocol.
+// Messages with an error are not sent to the TCI server.
+func (m Message) Err() error {
+	return m.err
 }
 
 func (m Message) String() string {
 	if len(m.args) == 0 {
 		return fmt.Sprintf("%s;", m.name)
 	}
-	return fmt.Sprintf("%s:%s;", m.name, strings.Join(m.args, ","))
+	args := make([]string, len(m.args))
+	for i, arg := range m.args {
+		args[i] = escapeArgument(arg)
+	}
+	return fmt.Sprintf("%s:%s;", m.name, strings.Join(args, ","))
 }
 
-// IsReplyTo indicates if this message is a reply to the given message.
+// IsReplyTo indicates if this message is a reply to the given message, i.e. it has the same name and starts with
+// the same arguments. Additional arguments of this message are the values requested by the given message.
 func (m Message) IsReplyTo(o Message) bool {
-	otherString := o.String()
-	prefix := otherString[0 : len(otherString)-1]
-	return strings.HasPrefix(m.String(), prefix)
+	if m.name != o.name || len(m.args) < len(o.args) {
+		return false
+	}
+	for i, arg := range o.args {
+		if m.args[i] != arg {
+			return false
+		}
+	}
+	return true
 }
 
 // Name of the message
@@ -131,62 +222,198 @@ func (m Message) ToFloat(i int) (float64, error) {
 	return strconv.ParseFloat(arg, 64)
 }
 
-// NewTXAudioMessage returns a binary message of type TXAudioStream that contains the given samples.
+// toMode returns the argument with the given index as Mode. The TCI server may send the mode in upper case.
+func (m Message) toMode(i int) (Mode, error) {
+	arg, err := m.arg(i)
+	if err != nil {
+		return "", err
+	}
+	return Mode(strings.ToLower(arg)), nil
+}
+
+// toAGCMode returns the argument with the given index as AGCMode. The TCI server may send the mode in upper case.
+func (m Message) toAGCMode(i int) (AGCMode, error) {
+	arg, err := m.arg(i)
+	if err != nil {
+		return "", err
+	}
+	return AGCMode(strings.ToLower(arg)), nil
+}
+
+// toSampleType returns the argument with the given index as SampleType.
+func (m Message) toSampleType(i int) (SampleType, error) {
+	arg, err := m.arg(i)
+	if err != nil {
+		return 0, err
+	}
+	return ParseSampleType(arg)
+}
+
+// NewTXAudioMessage returns a binary message of type TXAudioStream that contains the given samples as 32-bit float values.
+// The header uses the format value 4 that was always sent by this function, use NewTXAudioMessageWithSampleType to
+// send the samples as SampleTypeFloat32 instead.
 // The binary message can directly be send through a websocket connection to the TCI server.
 func NewTXAudioMessage(trx int, sampleRate AudioSampleRate, samples []float32) ([]byte, error) {
-	msg := &encodedBinaryMessage{
-		TRX:        uint32(trx),
-		SampleRate: uint32(sampleRate),
-		Format:     4,
-		Codec:      0,
-		CRC:        0,
+	return NewTXAudioMessageWithSampleType(trx, sampleRate, sampleTypeLegacyFloat32, samples)
+}
+
+// NewTXAudioMessageWithSampleType returns a binary message of type TXAudioStream that contains the given samples encoded
+// with the given sample type. The samples are expected to be normalized to the range [-1.0, 1.0].
+// The binary message can directly be send through a websocket connection to the TCI server.
+func NewTXAudioMessageWithSampleType(trx int, sampleRate AudioSampleRate, sampleType SampleType, samples []float32) ([]byte, error) {
+	msg := BinaryMessage{
+		TRX:        trx,
+		SampleRate: int(sampleRate),
+		Format:     int(sampleType),
 		DataLength: uint32(len(samples)),
-		Type:       uint32(TXAudioStreamMessage),
+		Type:       TXAudioStreamMessage,
+		Data:       samples,
 	}
+	return msg.Bytes()
+}
 
-	buf := bytes.NewBuffer(make([]byte, 0, 64+len(samples)*4))
-	err := binary.Write(buf, binary.LittleEndian, msg)
+// ParseBinaryMessage parses the given byte slice as incoming binary message.
+// The samples are decoded according to the sample type given in the header and normalized to the range [-1.0, 1.0].
+func ParseBinaryMessage(b []byte) (BinaryMessage, error) {
+	return ParseBinaryMessageInto(b, nil)
+}
+
+// ParseBinaryMessageInto is like ParseBinaryMessage, but decodes the samples into the given buffer if its capacity is
+// sufficient. The Data field of the result then shares its memory with the buffer. If the capacity of the buffer is
+// too small, a new buffer is allocated. Parsing a message into a sufficiently large buffer does not allocate memory.
+func ParseBinaryMessageInto(b []byte, buffer []float32) (BinaryMessage, error) {
+	if len(b) < binaryHeaderSize {
+		return BinaryMessage{}, fmt.Errorf("cannot read binary message header: %d bytes are too short", len(b))
+	}
+	result := BinaryMessage{
+		TRX:        int(binary.LittleEndian.Uint32(b[0:])),
+		SampleRate: int(binary.LittleEndian.Uint32(b[4:])),
+		Format:     int(binary.LittleEndian.Uint32(b[8:])),
+		Codec:      int(binary.LittleEndian.Uint32(b[12:])),
+		CRC:        binary.LittleEndian.Uint32(b[16:]),
+		DataLength: binary.LittleEndian.Uint32(b[20:]),
+		Type:       BinaryMessageType(binary.LittleEndian.Uint32(b[24:])),
+	}
+
+	if result.Type != TXChronoMessage && result.DataLength > 0 {
+		var err error
+		result.Data, err = decodeSamples(b[binaryHeaderSize:], SampleType(result.Format), result.DataLength, buffer)
+		if err != nil {
+			return BinaryMessage{}, fmt.Errorf("cannot read binary message data of type %d: %w", result.Type, err)
+		}
+	}
+
+	return result, nil
+}
+
+// Bytes encodes this binary message. The samples are encoded according to the sample type given in the Format field.
+func (m BinaryMessage) Bytes() ([]byte, error) {
+	sampleType := SampleType(m.Format)
+	sampleSize, err := sampleType.Size()
 	if err != nil {
-		return nil, fmt.Errorf("cannot write tx audio message header: %w", err)
+		return nil, err
 	}
-	err = binary.Write(buf, binary.LittleEndian, &samples)
+	header := encodedBinaryMessage{
+		TRX:        uint32(m.TRX),
+		SampleRate: uint32(m.SampleRate),
+		Format:     uint32(m.Format),
+		Codec:      uint32(m.Codec),
+		CRC:        m.CRC,
+		DataLength: m.DataLength,
+		Type:       uint32(m.Type),
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, binaryHeaderSize+len(m.Data)*sampleSize))
+	err = binary.Write(buf, binary.LittleEndian, &header)
 	if err != nil {
-		return nil, fmt.Errorf("cannot write tx audio message data: %w", err)
+		return nil, fmt.Errorf("cannot write binary message header: %w", err)
 	}
+	buf.Write(encodeSamples(m.Data, sampleType))
 
 	return buf.Bytes(), nil
 }
 
-// ParseBinaryMessage parses the given byte slice as incoming binary message.
-func ParseBinaryMessage(b []byte) (BinaryMessage, error) {
-	buf := bytes.NewReader(b)
-	var msg encodedBinaryMessage
-	err := binary.Read(buf, binary.LittleEndian, &msg)
+const (
+	binaryHeaderSize = 64
+
+	int16Scale = 1 << 15
+	int24Scale = 1 << 23
+	int32Scale = 1 << 31
+)
+
+// decodeSamples decodes count samples of the given type into the given buffer, or into a new buffer if the capacity of
+// the given buffer is too small. The count is taken from the untrusted message header, it is checked against the
+// available data before anything is allocated.
+func decodeSamples(b []byte, sampleType SampleType, count uint32, buffer []float32) ([]float32, error) {
+	sampleSize, err := sampleType.Size()
 	if err != nil {
-		return BinaryMessage{}, fmt.Errorf("cannot read binary message header: %v", err)
+		return nil, err
+	}
+	if uint64(len(b))/uint64(sampleSize) < uint64(count) {
+		return nil, fmt.Errorf("%d bytes of data are too short for %d %s samples", len(b), count, sampleType)
 	}
 
-	var data []float32
-	if BinaryMessageType(msg.Type) != TXChronoMessage && msg.DataLength > 0 {
-		data = make([]float32, msg.DataLength)
-		err = binary.Read(buf, binary.LittleEndian, &data)
-		if err != nil {
-			return BinaryMessage{}, fmt.Errorf("cannot read binary message data: %d %d %v", msg.Type, msg.DataLength, err)
+	var result []float32
+	if uint64(cap(buffer)) >= uint64(count) {
+		result = buffer[:count]
+	} else {
+		result = make([]float32, count)
+	}
+	switch sampleType {
+	case SampleTypeInt16:
+		for i := range result {
+			result[i] = float32(int16(binary.LittleEndian.Uint16(b[i*2:]))) / int16Scale
+		}
+	case SampleTypeInt24:
+		for i := range result {
+			sample := b[i*3 : i*3+3]
+			value := int32(uint32(sample[0])<<8|uint32(sample[1])<<16|uint32(sample[2])<<24) >> 8
+			result[i] = float32(value) / int24Scale
+		}
+	case SampleTypeInt32:
+		for i := range result {
+			result[i] = float32(float64(int32(binary.LittleEndian.Uint32(b[i*4:]))) / int32Scale)
+		}
+	default:
+		for i := range result {
+			result[i] = math.Float32frombits(binary.LittleEndian.Uint32(b[i*4:]))
 		}
 	}
+	return result, nil
+}
 
-	result := BinaryMessage{
-		TRX:        int(msg.TRX),
-		SampleRate: int(msg.SampleRate),
-		Format:     int(msg.Format),
-		Codec:      int(msg.Codec),
-		CRC:        msg.CRC,
-		DataLength: msg.DataLength,
-		Type:       BinaryMessageType(msg.Type),
-		Data:       data,
+func encodeSamples(samples []float32, sampleType SampleType) []byte {
+	sampleSize, _ := sampleType.Size()
+	result := make([]byte, len(samples)*sampleSize)
+	for i, value := range samples {
+		sample := result[i*sampleSize : (i+1)*sampleSize]
+		switch sampleType {
+		case SampleTypeInt16:
+			binary.LittleEndian.PutUint16(sample, uint16(int16(scaleSample(value, int16Scale))))
+		case SampleTypeInt24:
+			v := uint32(int32(scaleSample(value, int24Scale)))
+			sample[0] = byte(v)
+			sample[1] = byte(v >> 8)
+			sample[2] = byte(v >> 16)
+		case SampleTypeInt32:
+			binary.LittleEndian.PutUint32(sample, uint32(int32(scaleSample(value, int32Scale))))
+		default:
+			binary.LittleEndian.PutUint32(sample, math.Float32bits(value))
+		}
 	}
+	return result
+}
 
-	return result, nil
+// scaleSample scales the given normalized sample to the given integer range and clips it at the boundaries.
+func scaleSample(value float32, scale float64) int64 {
+	result := math.Round(float64(value) * scale)
+	if result >= scale {
+		return int64(scale) - 1
+	}
+	if result < -scale {
+		return -int64(scale)
+	}
+	return int64(result)
 }
 
 type encodedBinaryMessage struct {
@@ -210,6 +437,9 @@ type BinaryMessage struct {
 	DataLength uint32
 	Type       BinaryMessageType
 	Data       []float32
+
+	// samples is the pooled buffer that holds Data, or nil if Data is not pooled.
+	samples *pooledSamples
 }
 
 // BinaryMessageType represents the type of a BinaryMessage
@@ -1,9 +1,15 @@
 package client
 
 import (
+	"encoding/binary"
+	"math"
+	"math/rand"
+	"reflect"
 	"testing"
+	"testing/quick"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestParseTextMessage(t *testing.T) {
@@ -38,6 +44,10 @@ func TestParseTextMessage(t *testing.T) {
 func TestIsReplyTo(t *testing.T) {
 	assert.True(t, NewCommandMessage("name", 1, 2).IsReplyTo(NewRequestMessage("name", 1)))
 	assert.False(t, NewCommandMessage("name", 0, 2).IsReplyTo(NewRequestMessage("name", 1)))
+	assert.True(t, NewCommandMessage("rit_offset", 0, 1).IsReplyTo(NewCommandMessage("rit_offset", 0, 1)))
+	assert.False(t, NewCommandMessage("rit_offset", 0, 10).IsReplyTo(NewCommandMessage("rit_offset", 0, 1)))
+	assert.False(t, NewCommandMessage("rit_offset", 0).IsReplyTo(NewCommandMessage("rit_offset", 0, 1)))
+	assert.False(t, NewCommandMessage("trx_count", 2).IsReplyTo(NewRequestMessage("trx")))
 }
 
 func TestToFloat(t *testing.T) {
@@ -45,3 +55,371 @@ func TestToFloat(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, 13.5, f)
 }
+
+func TestBinaryMessage_SampleTypes(t *testing.T) {
+	samples := []float32{0, 0.5, -0.5, 0.25, -1}
+	for _, sampleType := range []SampleType{SampleTypeInt16, SampleTypeInt24, SampleTypeInt32, SampleTypeFloat32} {
+		t.Run(sampleType.String(), func(t *testing.T) {
+			encoded, err := NewTXAudioMessageWithSampleType(1, AudioSampleRate48k, sampleType, samples)
+			assert.NoError(t, err)
+			size, _ := sampleType.Size()
+			assert.Len(t, encoded, 64+len(samples)*size)
+
+			decoded, err := ParseBinaryMessage(encoded)
+			assert.NoError(t, err)
+			assert.Equal(t, 1, decoded.TRX)
+			assert.Equal(t, int(sampleType), decoded.Format)
+			assert.Equal(t, TXAudioStreamMessage, decoded.Type)
+			assert.InDeltaSlice(t, samples, decoded.Data, 1e-4)
+		})
+	}
+}
+
+func TestBinaryMessage_Int24(t *testing.T) {
+	header := make([]byte, 64)
+	header[8] = byte(SampleTypeInt24)
+	header[20] = 2
+	data := []byte{0x00, 0x00, 0x40, 0x00, 0x00, 0xc0}
+
+	decoded, err := ParseBinaryMessage(append(header, data...))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []float32{0.5, -0.5}, decoded.Data)
+}
+
+func TestBinaryMessage_ClipsIntegerSamples(t *testing.T) {
+	encoded, err := NewTXAudioMessageWithSampleType(0, AudioSampleRate48k, SampleTypeInt16, []float32{2, -2})
+	assert.NoError(t, err)
+
+	decoded, err := ParseBinaryMessage(encoded)
+
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, []float32{1, -1}, decoded.Data, 1e-4)
+}
+
+func TestBinaryMessage_UnknownSampleType(t *testing.T) {
+	header := make([]byte, 64+4)
+	header[8] = 9
+	header[20] = 1
+
+	_, err := ParseBinaryMessage(header)
+
+	assert.Error(t, err)
+}
+
+func TestNewCommandMessage_EncodesArguments(t *testing.T) {
+	msg := NewCommandMessage("test", 1, int64(-2), uint32(3), true, 13.5, float32(0.25), ModeCW, VFOB, NewARGB(255, 0, 0, 0), SampleTypeInt16, "a, b: c; d ")
+
+	assert.NoError(t, msg.Err())
+	assert.Equal(t, []string{"1", "-2", "3", "true", "13.5", "0.25", "cw", "1", "4278190080", "int16", "a, b: c; d "}, msg.Args())
+	assert.Equal(t, "test:1,-2,3,true,13.5,0.25,cw,1,4278190080,int16,a~ b^ c* d ;", msg.String())
+}
+
+func TestNewCommandMessage_RejectsUnrepresentableArguments(t *testing.T) {
+	tt := []struct {
+		desc  string
+		name  string
+		value interface{}
+	}{
+		{desc: "escape character", name: "spot", value: "a^b"},
+		{desc: "control character", name: "spot", value: "a\nb"},
+		{desc: "NaN", name: "tx_power", value: math.NaN()},
+		{desc: "infinity", name: "tx_power", value: math.Inf(1)},
+		{desc: "unsupported type", name: "spot", value: []int{1}},
+		{desc: "invalid name", name: "spot:", value: 1},
+	}
+	for _, tc := range tt {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := NewCommandMessage(tc.name, tc.value)
+			assert.ErrorIs(t, msg.Err(), ErrInvalidArgument)
+		})
+	}
+}
+
+func TestNewTXAudioMessage_KeepsLegacyFormat(t *testing.T) {
+	encoded, err := NewTXAudioMessage(1, AudioSampleRate48k, []float32{0.5, -0.5})
+	require.NoError(t, err)
+	decoded, err := ParseBinaryMessage(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, 4, decoded.Format)
+	assert.Equal(t, []float32{0.5, -0.5}, decoded.Data)
+
+	encoded, err = NewTXAudioMessageWithSampleType(1, AudioSampleRate48k, SampleTypeFloat32, []float32{0.5, -0.5})
+	require.NoError(t, err)
+	decoded, err = ParseBinaryMessage(encoded)
+	require.NoError(t, err)
+	assert.Equal(t, int(SampleTypeFloat32), decoded.Format)
+	assert.Equal(t, []float32{0.5, -0.5}, decoded.Data)
+}
+
+func TestParseBinaryMessageInto(t *testing.T) {
+	encoded, err := NewTXAudioMessage(1, AudioSampleRate48k, []float32{0.5, -0.5, 0.25})
+	require.NoError(t, err)
+
+	t.Run("sufficient buffer", func(t *testing.T) {
+		buffer := make([]float32, 0, 4)
+		var decoded BinaryMessage
+		allocs := testing.AllocsPerRun(10, func() {
+			decoded, err = ParseBinaryMessageInto(encoded, buffer)
+		})
+
+		assert.NoError(t, err)
+		assert.Zero(t, allocs)
+		assert.Equal(t, []float32{0.5, -0.5, 0.25}, decoded.Data)
+		assert.Same(t, &buffer[:1][0], &decoded.Data[0])
+	})
+	t.Run("small buffer", func(t *testing.T) {
+		buffer := make([]float32, 2)
+
+		decoded, err := ParseBinaryMessageInto(encoded, buffer)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []float32{0.5, -0.5, 0.25}, decoded.Data)
+		assert.Equal(t, []float32{0, 0}, buffer)
+	})
+}
+
+func TestBinaryMessage_DataLengthExceedsData(t *testing.T) {
+	for _, sampleType := range []SampleType{SampleTypeInt16, SampleTypeInt24, SampleTypeInt32, SampleTypeFloat32} {
+		t.Run(sampleType.String(), func(t *testing.T) {
+			b := make([]byte, 64+8)
+			binary.LittleEndian.PutUint32(b[8:], uint32(sampleType))
+			binary.LittleEndian.PutUint32(b[20:], math.MaxUint32)
+
+			var err error
+			allocs := testing.AllocsPerRun(10, func() {
+				_, err = ParseBinaryMessage(b)
+			})
+
+			assert.Error(t, err)
+			assert.Less(t, allocs, float64(20), "the data must not be allocated")
+		})
+	}
+}
+
+// textMessageSample is a random command message with arguments of all types that can be represented in the TCI text
+// protocol.
+type textMessageSample struct {
+	name string
+	args []interface{}
+}
+
+const (
+	nameCharacters = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789_"
+	textCharacters = "abcXYZ0189 .-_/:,;äöüß€"
+)
+
+func (textMessageSample) Generate(r *rand.Rand, size int) reflect.Value {
+	name := make([]byte, 1+r.Intn(16))
+	for i := range name {
+		name[i] = nameCharacters[r.Intn(len(nameCharacters))]
+	}
+	args := make([]interface{}, r.Intn(size+1))
+	for i := range args {
+		switch r.Intn(4) {
+		case 0:
+			args[i] = r.Int63() - r.Int63()
+		case 1:
+			args[i] = r.NormFloat64() * math.Pow10(r.Intn(12))
+		case 2:
+			args[i] = r.Intn(2) == 1
+		default:
+			text := []rune(textCharacters)
+			arg := make([]rune, r.Intn(size+1))
+			for j := range arg {
+				arg[j] = text[r.Intn(len(text))]
+			}
+			args[i] = string(arg)
+		}
+	}
+	return reflect.ValueOf(textMessageSample{name: string(name), args: args})
+}
+
+func TestTextMessage_RoundTrip(t *testing.T) {
+	property := func(sample textMessageSample) bool {
+		msg := NewCommandMessage(sample.name, sample.args...)
+		if msg.Err() != nil {
+			t.Logf("%v: %v", sample, msg.Err())
+			return false
+		}
+		parsed, err := ParseTextMessage(msg.String())
+		if err != nil {
+			t.Logf("%q: %v", msg.String(), err)
+			return false
+		}
+		return reflect.DeepEqual(msg, parsed)
+	}
+
+	assert.NoError(t, quick.Check(property, nil))
+}
+
+// audioSample is a random audio signal normalized to the range [-1.0, 1.0].
+type audioSample []float32
+
+func (audioSample) Generate(r *rand.Rand, size int) reflect.Value {
+	result := make(audioSample, r.Intn(size+1))
+	for i := range result {
+		result[i] = r.Float32()*2 - 1
+	}
+	return reflect.ValueOf(result)
+}
+
+func TestBinaryMessage_RoundTrip(t *testing.T) {
+	tolerances := map[SampleType]float64{
+		SampleTypeInt16:   1.0 / int16Scale,
+		SampleTypeInt24:   1.0 / int24Scale,
+		SampleTypeInt32:   1e-7,
+		SampleTypeFloat32: 0,
+	}
+	for sampleType, tolerance := range tolerances {
+		sampleType, tolerance := sampleType, tolerance
+		t.Run(sampleType.String(), func(t *testing.T) {
+			property := func(trx uint8, samples audioSample) bool {
+				encoded, err := NewTXAudioMessageWithSampleType(int(trx), AudioSampleRate48k, sampleType, samples)
+				if err != nil {
+					return false
+				}
+				decoded, err := ParseBinaryMessage(encoded)
+				if err != nil {
+					return false
+				}
+				if decoded.TRX != int(trx) || decoded.SampleRate != int(AudioSampleRate48k) || decoded.Format != int(sampleType) ||
+					decoded.Type != TXAudioStreamMessage || int(decoded.DataLength) != len(samples) || len(decoded.Data) != len(samples) {
+					return false
+				}
+				for i, sample := range samples {
+					if math.Abs(float64(sample-decoded.Data[i])) > tolerance {
+						return false
+					}
+				}
+				return true
+			}
+
+			assert.NoError(t, quick.Check(property, nil))
+		})
+	}
+}
+
+func FuzzParseTextMessage(f *testing.F) {
+	for _, seed := range []string{
+		"start;",
+		"dds:0,123;",
+		"if:0,-1200;",
+		"rit_enable:0,true;",
+		"tx_power:13.5;",
+		"spot:DL1ABC,cw,7012000,12711680,a~ b^ c* d ;",
+		"  VFO:0,1,7012000;\r\n",
+		"protocol:ExpertSDR3,1.9;",
+		"empty:;",
+		"dds:0,123;start;",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, s string) {
+		msg, err := ParseTextMessage(s)
+		if err != nil {
+			return
+		}
+
+		reparsed, err := ParseTextMessage(msg.String())
+
+		require.NoError(t, err, "%q", msg.String())
+		assert.Equal(t, msg, reparsed)
+	})
+}
+
+func FuzzParseBinaryMessage(f *testing.F) {
+	for _, sampleType := range []SampleType{SampleTypeInt16, SampleTypeInt24, SampleTypeInt32, SampleTypeFloat32} {
+		seed, err := NewTXAudioMessageWithSampleType(1, AudioSampleRate48k, sampleType, []float32{0, 0.5, -0.5, 1, -1})
+		require.NoError(f, err)
+		f.Add(seed)
+	}
+	chrono := make([]byte, 64)
+	binary.LittleEndian.PutUint32(chrono[20:], 2048)
+	binary.LittleEndian.PutUint32(chrono[24:], uint32(TXChronoMessage))
+	f.Add(chrono)
+	f.Add(make([]byte, 63))
+
+	f.Fuzz(func(t *testing.T, b []byte) {
+		msg, err := ParseBinaryMessage(b)
+		if err != nil {
+			return
+		}
+		require.LessOrEqual(t, len(msg.Data), len(b)/2)
+		if msg.Type != TXChronoMessage {
+			require.Len(t, msg.Data, int(msg.DataLength))
+		}
+
+		encoded, err := msg.Bytes()
+		if err != nil {
+			assert.Empty(t, msg.Data, "only messages without data may have an unknown sample type")
+			return
+		}
+		reparsed, err := ParseBinaryMessage(encoded)
+
+		require.NoError(t, err)
+		assert.Equal(t, msg.TRX, reparsed.TRX)
+		assert.Equal(t, msg.SampleRate, reparsed.SampleRate)
+		assert.Equal(t, msg.Format, reparsed.Format)
+		assert.Equal(t, msg.Codec, reparsed.Codec)
+		assert.Equal(t, msg.CRC, reparsed.CRC)
+		assert.Equal(t, msg.DataLength, reparsed.DataLength)
+		assert.Equal(t, msg.Type, reparsed.Type)
+		require.Len(t, reparsed.Data, len(msg.Data))
+		for i := range msg.Data {
+			assert.Equal(t, math.Float32bits(msg.Data[i]), math.Float32bits(reparsed.Data[i]), "sample %d", i)
+		}
+	})
+}
+
+func iqFrame(sampleType SampleType, count int) []byte {
+	samples := make([]float32, count)
+	for i := range samples {
+		samples[i] = float32(math.Sin(float64(i) / 10))
+	}
+	result, err := BinaryMessage{
+		SampleRate: int(IQSampleRate192k),
+		Format:     int(sampleType),
+		DataLength: uint32(count),
+		Type:       IQStreamMessage,
+		Data:       samples,
+	}.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func BenchmarkParseBinaryMessage(b *testing.B) {
+	for _, sampleType := range []SampleType{SampleTypeInt16, SampleTypeInt24, SampleTypeInt32, SampleTypeFloat32} {
+		frame := iqFrame(sampleType, 2048)
+		b.Run(sampleType.String(), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(frame)))
+			for i := 0; i < b.N; i++ {
+				_, err := ParseBinaryMessage(frame)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkParseBinaryMessageInto(b *testing.B) {
+	for _, sampleType := range []SampleType{SampleTypeInt16, SampleTypeInt24, SampleTypeInt32, SampleTypeFloat32} {
+		frame := iqFrame(sampleType, 2048)
+		buffer := make([]float32, 2048)
+		b.Run(sampleType.String(), func(b *testing.B) {
+			b.ReportAllocs()
+			b.SetBytes(int64(len(frame)))
+			for i := 0; i < b.N; i++ {
+				_, err := ParseBinaryMessageInto(frame, buffer)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -1,1799 +1,371 @@
 package client
 
 import (
-	"log"
-	"strings"
+	"fmt"
+	"strconv"
+	"sync"
 )
 
 const (
-	tci_1_4 tciVersion = 1.4
-	tci_1_5 tciVersion = 1.5
+	tci_1_4 Version = 1.4
+	tci_1_5 Version = 1.5
 )
 
-type tciVersion float64
+// Version is a version of the TCI protocol.
+type Version float64
 
 // Beyond indicates if this TCI version is beyond the given version.
-func (v tciVersion) Beyond(o tciVersion) bool {
+func (v Version) Beyond(o Version) bool {
 	return v > o
 }
 
 // AtLeast indicates if this TCI version is at least the given version.
-func (v tciVersion) AtLeast(o tciVersion) bool {
+func (v Version) AtLeast(o Version) bool {
 	return v >= o
 }
 
-func newNotifier(listeners []interface{}, closed <-chan struct{}) *notifier {
-	result := &notifier{
-		listeners:      listeners,
-		closed:         closed,
-		textMessages:   make(chan Message, 1),
-		binaryMessages: make(chan BinaryMessage, 1),
-		tciVersion:     1.4,
-	}
-	go result.notifyLoop()
-	return result
-}
-
-type notifier struct {
-	listeners      []interface{}
-	closed         <-chan struct{}
-	textMessages   chan Message
-	binaryMessages chan BinaryMessage
-	tciName        string
-	tciVersion     tciVersion
-}
-
-func (n *notifier) notifyLoop() {
-	for {
-		select {
-		case <-n.closed:
-			return
-		case msg := <-n.textMessages:
-			n.handleIncomingMessage(msg)
-		case msg := <-n.binaryMessages:
-			n.handleIncomingBinaryMessage(msg)
-		}
-	}
-}
-
-// Notify registers the given listener. The listener is then notified about incoming messages.
-func (n *notifier) Notify(listener interface{}) {
-	n.listeners = append(n.listeners, listener)
-}
-
-func (n *notifier) textMessage(msg Message) {
-	n.textMessages <- msg
-}
-
-func (n *notifier) handleIncomingMessage(msg Message) {
-	n.emitMessage(msg)
-	var err error
-	switch msg.name {
-	case "protocol":
-		n.setTCIProtocol(msg)
-		err = n.emitProtocol(msg)
-	case "vfo_limits":
-		err = n.emitVFOLimits(msg)
-	case "if_limits":
-		err = n.emitIFLimits(msg)
-	case "trx_count":
-		err = n.emitTRXCount(msg)
-	case "channels_count":
-		err = n.emitChannelCount(msg)
-	case "device":
-		err = n.emitDeviceName(msg)
-	case "receive_only":
-		err = n.emitRXOnly(msg)
-	case "modulations_list":
-		err = n.emitModes(msg)
-	case "tx_enable":
-		err = n.emitTXEnable(msg)
-	case "ready":
-		err = n.emitReady(msg)
-	case "tx_footswitch":
-		err = n.emitTXFootswitch(msg)
-	case "start":
-		err = n.emitStart(msg)
-	case "stop":
-		err = n.emitStop(msg)
-	case "dds":
-		err = n.emitDDS(msg)
-	case "if":
-		err = n.emitIF(msg)
-	case "rit_enable":
-		err = n.emitRITEnable(msg)
-	case "modulation":
-		err = n.emitMode(msg)
-	case "rx_enable":
-		err = n.emitRXEnable(msg)
-	case "xit_enable":
-		err = n.emitXITEnable(msg)
-	case "split_enable":
-		err = n.emitSplitEnable(msg)
-	case "rit_offset":
-		err = n.emitRITOffset(msg)
-	case "xit_offset":
-		err = n.emitXITOffset(msg)
-	case "rx_channel_enable":
-		err = n.emitRXChannelEnable(msg)
-	case "rx_filter_band":
-		err = n.emitRXFilterBand(msg)
-	case "rx_smeter":
-		err = n.emitRXSMeter(msg)
-	case "cw_macros_speed":
-		err = n.emitCWMacrosSpeed(msg)
-	case "cw_macros_delay":
-		err = n.emitCWMacrosDelay(msg)
-	case "cw_macros_empty":
-		err = n.emitCWMacrosEmpty(msg)
-	case "trx":
-		err = n.emitTX(msg)
-	case "tune":
-		err = n.emitTune(msg)
-	case "drive":
-		err = n.emitDrive(msg)
-	case "tune_drive":
-		err = n.emitTuneDrive(msg)
-	case "iq_start":
-		err = n.emitStartIQ(msg)
-	case "iq_stop":
-		err = n.emitStopIQ(msg)
-	case "iq_samplerate":
-		err = n.emitIQSampleRate(msg)
-	case "audio_start":
-		err = n.emitStartAudio(msg)
-	case "audio_stop":
-		err = n.emitStopAudio(msg)
-	case "audio_samplerate":
-		err = n.emitAudioSampleRate(msg)
-	case "tx_power":
-		err = n.emitTXPower(msg)
-	case "tx_swr":
-		err = n.emitTXSWR(msg)
-	case "volume":
-		err = n.emitVolume(msg)
-	case "sql_enable":
-		err = n.emitSquelchEnable(msg)
-	case "sql_level":
-		err = n.emitSquelchLevel(msg)
-	case "vfo":
-		err = n.emitVFOFrequency(msg)
-	case "app_focus":
-		err = n.emitAppFocus(msg)
-	case "mute":
-		err = n.emitMute(msg)
-	case "rx_mute":
-		err = n.emitRXMute(msg)
-	case "ctcss_enable":
-		err = n.emitCTCSSEnable(msg)
-	case "ctcss_mode":
-		err = n.emitCTCSSMode(msg)
-	case "ctcss_rx_tone":
-		err = n.emitCTCSSRXTone(msg)
-	case "ctcss_tx_tone":
-		err = n.emitCTCSSTXTone(msg)
-	case "ctcss_level":
-		err = n.emitCTCSSLevel(msg)
-	case "ecoder_switch_rx":
-		err = n.emitECoderSwitchRX(msg)
-	case "ecoder_switch_channel":
-		err = n.emitECoderSwitchChannel(msg)
-	case "rx_volume":
-		err = n.emitRXVolume(msg)
-	case "rx_balance":
-		err = n.emitRXBalance(msg)
-	case "rx_sensors":
-		err = n.emitRXSensors(msg)
-	case "tx_sensors":
-		err = n.emitTXSensors(msg)
-	case "rx_nb_enable":
-		err = n.emitRXNBEnable(msg)
-	case "rx_nb_param":
-		err = n.emitRXNBParams(msg)
-	case "rx_bin_enable":
-		err = n.emitRXBinEnable(msg)
-	case "rx_nr_enable":
-		err = n.emitRXNREnable(msg)
-	case "rx_anc_enable":
-		err = n.emitRXANCEnable(msg)
-	case "rx_anf_enable":
-		err = n.emitRXANFEnable(msg)
-	case "rx_apf_enable":
-		err = n.emitRXAPFEnable(msg)
-	case "rx_dse_enable":
-		err = n.emitRXDSEEnable(msg)
-	case "rx_nf_enable":
-		err = n.emitRXNFEnable(msg)
-	case "tx_frequency":
-		err = n.emitTXFrequency(msg)
-	default:
-		log.Printf("unknown incoming message: %v", msg)
-	}
-	if err != nil {
-		log.Printf("cannot emit message %v: %v", msg, err)
-	}
-}
-
-func (n *notifier) setTCIProtocol(msg Message) {
-	name, err := msg.ToString(0)
-	if err != nil {
-		log.Printf("Cannot parse protocol message: %v", err)
-		return
-	}
-	version, err := msg.ToFloat(1)
-	if err != nil {
-		log.Printf("Cannot parse protocol version: %v", err)
-		return
-	}
-
-	n.tciName = name
-	n.tciVersion = tciVersion(version)
-}
-
-// MessageListener is notified when any text message is received from the TCI server.
-type MessageListener interface {
-	Message(msg Message)
-}
-
-func (n *notifier) emitMessage(msg Message) {
-	for _, l := range n.listeners {
-		if listener, ok := l.(MessageListener); ok {
-			listener.Message(msg)
-		}
-	}
-}
-
-// A ProtocolListener is notified when a PROTOCOL message is received from the TCI server.
-type ProtocolListener interface {
-	SetProtocol(name string, version string)
-}
-
-func (n *notifier) emitProtocol(msg Message) error {
-	name, err := msg.ToString(0)
-	if err != nil {
-		return err
-	}
-	version, err := msg.ToString(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(ProtocolListener); ok {
-			listener.SetProtocol(name, version)
-		}
-	}
-	return nil
-}
-
-// A VFOLimitsListener is notified when a VFO_LIMITS message is received from the TCI server.
-type VFOLimitsListener interface {
-	SetVFOLimits(min, max int)
-}
-
-func (n *notifier) emitVFOLimits(msg Message) error {
-	min, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	max, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(VFOLimitsListener); ok {
-			listener.SetVFOLimits(min, max)
-		}
-	}
-	return nil
-}
-
-// An IFLimitsListener is notified when an IF_LIMITS message is received from the TCI server.
-type IFLimitsListener interface {
-	SetIFLimits(min, max int)
-}
-
-func (n *notifier) emitIFLimits(msg Message) error {
-	min, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	max, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(IFLimitsListener); ok {
-			listener.SetIFLimits(min, max)
-		}
-	}
-	return nil
-}
-
-// A TRXCountListener is notified when a TRX_COUNT message is received from the TCI server.
-type TRXCountListener interface {
-	SetTRXCount(count int)
-}
-
-func (n *notifier) emitTRXCount(msg Message) error {
-	count, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(TRXCountListener); ok {
-			listener.SetTRXCount(count)
-		}
-	}
-	return nil
-}
-
-// A ChannelCountListener is notified when a CHANNEL_COUNT message is received from the TCI server.
-type ChannelCountListener interface {
-	SetChannelCount(count int)
-}
-
-func (n *notifier) emitChannelCount(msg Message) error {
-	count, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(ChannelCountListener); ok {
-			listener.SetChannelCount(count)
-		}
-	}
-	return nil
-}
-
-// A DeviceNameListener is notified when a DEVICE message is received from the TCI server.
-type DeviceNameListener interface {
-	SetDeviceName(name string)
-}
-
-func (n *notifier) emitDeviceName(msg Message) error {
-	name, err := msg.ToString(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(DeviceNameListener); ok {
-			listener.SetDeviceName(name)
-		}
-	}
-	return nil
-}
-
-// A RXOnlyListener is notified when a RECEIVE_ONLY message is received from the TCI server.
-type RXOnlyListener interface {
-	SetRXOnly(value bool)
-}
-
-func (n *notifier) emitRXOnly(msg Message) error {
-	value, err := msg.ToBool(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXOnlyListener); ok {
-			listener.SetRXOnly(value)
-		}
-	}
-	return nil
-}
-
-// A ModesListener is notified when a MODULATIONS_LIST message is received from the TCI server.
-type ModesListener interface {
-	SetModes(modes []Mode)
-}
-
-func (n *notifier) emitModes(msg Message) error {
-	modes := make([]Mode, len(msg.args))
-	for i, arg := range msg.args {
-		modes[i] = Mode(arg)
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(ModesListener); ok {
-			listener.SetModes(modes)
-		}
-	}
-	return nil
-}
-
-// A TXEnableListener is notified when a TX_ENABLE message is received from the TCI server.
-type TXEnableListener interface {
-	SetTXEnable(trx int, enabled bool)
-}
-
-func (n *notifier) emitTXEnable(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	enabled, err := msg.ToBool(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(TXEnableListener); ok {
-			listener.SetTXEnable(trx, enabled)
-		}
-	}
-	return nil
-}
-
-// A ReadyListener is notified when a READY message is received from the TCI server.
-type ReadyListener interface {
-	Ready()
-}
-
-func (n *notifier) emitReady(Message) error {
-	for _, l := range n.listeners {
-		if listener, ok := l.(ReadyListener); ok {
-			listener.Ready()
-		}
-	}
-	return nil
-}
-
-// A TXFootswitchListener is notified when a TX_FOOTSWITCH message is received from the TCI server.
-type TXFootswitchListener interface {
-	SetTXFootswitch(trx int, pressed bool)
-}
-
-func (n *notifier) emitTXFootswitch(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	pressed, err := msg.ToBool(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(TXFootswitchListener); ok {
-			listener.SetTXFootswitch(trx, pressed)
-		}
-	}
-	return nil
-}
-
-// A StartListener is notified when a START message is received from the TCI server.
-type StartListener interface {
-	Start()
-}
-
-func (n *notifier) emitStart(Message) error {
-	for _, l := range n.listeners {
-		if listener, ok := l.(StartListener); ok {
-			listener.Start()
-		}
-	}
-	return nil
-}
-
-// A StopListener is notified when a STOP message is received from the TCI server.
-type StopListener interface {
-	Stop()
-}
-
-func (n *notifier) emitStop(Message) error {
-	for _, l := range n.listeners {
-		if listener, ok := l.(StopListener); ok {
-			listener.Stop()
-		}
-	}
-	return nil
-}
-
-// A DDSListener is notified when a DDS message is received from the TCI server.
-type DDSListener interface {
-	SetDDS(trx int, frequency int)
-}
-
-func (n *notifier) emitDDS(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	frequency, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(DDSListener); ok {
-			listener.SetDDS(trx, frequency)
-		}
-	}
-	return nil
-}
-
-// An IFListener is notified when an IF_LIMITS message is received from the TCI server.
-type IFListener interface {
-	SetIF(trx int, vfo VFO, frequency int)
-}
-
-func (n *notifier) emitIF(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	vfo, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	frequency, err := msg.ToInt(2)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(IFListener); ok {
-			listener.SetIF(trx, VFO(vfo), frequency)
-		}
-	}
-	return nil
-}
-
-// A RITEnableListener is notified when a RIT_ENABLE message is received from the TCI server.
-type RITEnableListener interface {
-	SetRITEnable(trx int, enabled bool)
-}
-
-func (n *notifier) emitRITEnable(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	enabled, err := msg.ToBool(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RITEnableListener); ok {
-			listener.SetRITEnable(trx, enabled)
-		}
-	}
-	return nil
-}
-
-// A ModeListener is notified when a MODULATION message is received from the TCI server.
-type ModeListener interface {
-	SetMode(trx int, mode Mode)
-}
-
-func (n *notifier) emitMode(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	mode, err := msg.ToString(1)
-	if err != nil {
-		return err
-	}
-	mode = strings.ToLower(mode)
-	for _, l := range n.listeners {
-		if listener, ok := l.(ModeListener); ok {
-			listener.SetMode(trx, Mode(mode))
-		}
-	}
-	return nil
-}
-
-// A RXEnableListener is notified when a RX_ENABLE message is received from the TCI server.
-type RXEnableListener interface {
-	SetRXEnable(trx int, enabled bool)
-}
-
-func (n *notifier) emitRXEnable(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	enabled, err := msg.ToBool(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXEnableListener); ok {
-			listener.SetRXEnable(trx, enabled)
-		}
-	}
-	return nil
-}
-
-// A XITEnableListener is notified when a XIT_ENABLE message is received from the TCI server.
-type XITEnableListener interface {
-	SetXITEnable(trx int, enabled bool)
-}
-
-func (n *notifier) emitXITEnable(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	enabled, err := msg.ToBool(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(XITEnableListener); ok {
-			listener.SetXITEnable(trx, enabled)
-		}
-	}
-	return nil
-}
-
-// A SplitEnableListener is notified when a SPLIT_ENABLE message is received from the TCI server.
-type SplitEnableListener interface {
-	SetSplitEnable(trx int, enabled bool)
-}
-
-func (n *notifier) emitSplitEnable(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	enabled, err := msg.ToBool(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(SplitEnableListener); ok {
-			listener.SetSplitEnable(trx, enabled)
-		}
-	}
-	return nil
-}
-
-// A RITOffsetListener is notified when a RIT_OFFSET message is received from the TCI server.
-type RITOffsetListener interface {
-	SetRITOffset(trx int, offset int)
-}
-
-func (n *notifier) emitRITOffset(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	offset, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RITOffsetListener); ok {
-			listener.SetRITOffset(trx, offset)
-		}
-	}
-	return nil
-}
-
-// A XITOffsetListener is notified when a XIT_OFFSET message is received from the TCI server.
-type XITOffsetListener interface {
-	SetXITOffset(trx int, offset int)
-}
-
-func (n *notifier) emitXITOffset(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	offset, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(XITOffsetListener); ok {
-			listener.SetXITOffset(trx, offset)
-		}
-	}
-	return nil
-}
-
-// A RXChannelEnableListener is notified when a RX_CHANNEL_ENABLE message is received from the TCI server.
-type RXChannelEnableListener interface {
-	SetRXChannelEnable(trx int, vfo VFO, enabled bool)
-}
-
-func (n *notifier) emitRXChannelEnable(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	vfo, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	enabled, err := msg.ToBool(2)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXChannelEnableListener); ok {
-			listener.SetRXChannelEnable(trx, VFO(vfo), enabled)
-		}
-	}
-	return nil
-}
-
-// A RXFilterBandListener is notified when a RX_FILTER_BAND message is received from the TCI server.
-type RXFilterBandListener interface {
-	SetRXFilterBand(trx int, min, max int)
-}
-
-func (n *notifier) emitRXFilterBand(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	min, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	max, err := msg.ToInt(2)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXFilterBandListener); ok {
-			listener.SetRXFilterBand(trx, min, max)
-		}
-	}
-	return nil
-}
-
-// A RXSMeterListener is notified when a RX_SMETER message is received from the TCI server.
-type RXSMeterListener interface {
-	SetRXSMeter(trx int, vfo VFO, level int)
-}
-
-func (n *notifier) emitRXSMeter(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	vfo, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	level, err := msg.ToInt(2)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXSMeterListener); ok {
-			listener.SetRXSMeter(trx, VFO(vfo), level)
-		}
-	}
-	return nil
-}
-
-// A CWMacrosSpeedListener is notified when a CW_MACROS_SPEED message is received from the TCI server.
-type CWMacrosSpeedListener interface {
-	SetCWMacrosSpeed(wpm int)
-}
-
-func (n *notifier) emitCWMacrosSpeed(msg Message) error {
-	wpm, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(CWMacrosSpeedListener); ok {
-			listener.SetCWMacrosSpeed(wpm)
-		}
-	}
-	return nil
-}
-
-// A CWMacrosDelayListener is notified when a CW_MACROS_DELAY message is received from the TCI server.
-type CWMacrosDelayListener interface {
-	SetCWMacrosDelay(delay int)
-}
-
-func (n *notifier) emitCWMacrosDelay(msg Message) error {
-	delay, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(CWMacrosDelayListener); ok {
-			listener.SetCWMacrosDelay(delay)
-		}
-	}
-	return nil
-}
-
-// A CWMacrosEmptyListener is notified then a CW_MACROS_EMPTY message is received from the TCI server. (since TCI 1.5)
-type CWMacrosEmptyListener interface {
-	CWMacrosEmpty()
-}
-
-func (n *notifier) emitCWMacrosEmpty(msg Message) error {
-	for _, l := range n.listeners {
-		if listener, ok := l.(CWMacrosEmptyListener); ok {
-			listener.CWMacrosEmpty()
-		}
-	}
-	return nil
-}
-
-// A TXListener is notified when a TRX message is received from the TCI server.
-type TXListener interface {
-	SetTX(trx int, enabled bool)
-}
-
-func (n *notifier) emitTX(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	enabled, err := msg.ToBool(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(TXListener); ok {
-			listener.SetTX(trx, enabled)
-		}
-	}
-	return nil
-}
-
-// A TuneListener is notified when a TUNE message is received from the TCI server.
-type TuneListener interface {
-	SetTune(trx int, enabled bool)
-}
-
-func (n *notifier) emitTune(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	enabled, err := msg.ToBool(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(TuneListener); ok {
-			listener.SetTune(trx, enabled)
-		}
-	}
-	return nil
-}
-
-// A DriveListener is notified when a DRIVE message is received from the TCI server.
-type DriveListener interface {
-	SetDrive(percent int)
-}
-
-// A TRXDriveListener is notified when a DRIVE message for a certain TRX is received from the TCI server. (since TCI 1.5)
-type TRXDriveListener interface {
-	SetTRXDrive(trx int, percent int)
-}
-
-func (n *notifier) emitDrive(msg Message) error {
-	if n.tciVersion.Beyond(tci_1_4) {
-		return n.emitTRXDrive(msg)
-	}
-
-	percent, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(DriveListener); ok {
-			listener.SetDrive(percent)
-		}
-	}
-	return nil
-}
-
-func (n *notifier) emitTRXDrive(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	percent, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(TRXDriveListener); ok {
-			listener.SetTRXDrive(trx, percent)
-		} else if listener, ok := l.(DriveListener); ok && (trx == 0) {
-			listener.SetDrive(percent)
-		}
-	}
-	return nil
-}
-
-// A TuneDriveListener is notified when a TUNE_DRIVE message is received from the TCI server.
-type TuneDriveListener interface {
-	SetTuneDrive(percent int)
-}
-
-// A TRXTuneDriveListener is notified when a TUNE_DRIVE message for a certain TRX is received from the TCI server.
-type TRXTuneDriveListener interface {
-	SetTRXTuneDrive(trx int, percent int)
-}
-
-func (n *notifier) emitTuneDrive(msg Message) error {
-	if n.tciVersion.Beyond(tci_1_4) {
-		return n.emitTRXTuneDrive(msg)
-	}
-
-	percent, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(TuneDriveListener); ok {
-			listener.SetTuneDrive(percent)
-		}
-	}
-	return nil
-}
-
-func (n *notifier) emitTRXTuneDrive(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	percent, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(TRXTuneDriveListener); ok {
-			listener.SetTRXTuneDrive(trx, percent)
-		} else if listener, ok := l.(TuneDriveListener); ok && (trx == 0) {
-			listener.SetTuneDrive(percent)
-		}
-	}
-	return nil
-}
-
-// A StartIQListener is notified when a IQ_START message is received from the TCI server.
-type StartIQListener interface {
-	StartIQ(trx int)
-}
-
-func (n *notifier) emitStartIQ(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(StartIQListener); ok {
-			listener.StartIQ(trx)
-		}
-	}
-	return nil
-}
-
-// A StopIQListener is notified when a IQ_STOP message is received from the TCI server.
-type StopIQListener interface {
-	StopIQ(trx int)
-}
-
-func (n *notifier) emitStopIQ(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(StopIQListener); ok {
-			listener.StopIQ(trx)
-		}
-	}
-	return nil
-}
-
-// A IQSampleRateListener is notified when a IQ_SAMPLERATE message is received from the TCI server.
-type IQSampleRateListener interface {
-	SetIQSampleRate(sampleRate IQSampleRate)
-}
-
-func (n *notifier) emitIQSampleRate(msg Message) error {
-	sampleRate, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(IQSampleRateListener); ok {
-			listener.SetIQSampleRate(IQSampleRate(sampleRate))
-		}
-	}
-	return nil
-}
-
-// A StartAudioListener is notified when a AUDIO_START message is received from the TCI server.
-type StartAudioListener interface {
-	StartAudio(trx int)
-}
-
-func (n *notifier) emitStartAudio(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(StartAudioListener); ok {
-			listener.StartAudio(trx)
-		}
-	}
-	return nil
-}
-
-// A StopAudioListener is notified when a AUDIO_STOP message is received from the TCI server.
-type StopAudioListener interface {
-	StopAudio(trx int)
-}
-
-func (n *notifier) emitStopAudio(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(StopAudioListener); ok {
-			listener.StopAudio(trx)
-		}
-	}
-	return nil
-}
-
-// A AudioSampleRateListener is notified when a AUDIO_SAMPLERATE message is received from the TCI server.
-type AudioSampleRateListener interface {
-	SetAudioSampleRate(sampleRate AudioSampleRate)
-}
-
-func (n *notifier) emitAudioSampleRate(msg Message) error {
-	sampleRate, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(AudioSampleRateListener); ok {
-			listener.SetAudioSampleRate(AudioSampleRate(sampleRate))
-		}
-	}
-	return nil
-}
-
-// A TXPowerListener is notified when a TX_POWER message is received from the TCI server.
-type TXPowerListener interface {
-	SetTXPower(watts float64)
-}
-
-func (n *notifier) emitTXPower(msg Message) error {
-	watts, err := msg.ToFloat(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(TXPowerListener); ok {
-			listener.SetTXPower(watts)
-		}
-	}
-	return nil
-}
-
-// A TXSWRListener is notified when a TX_SWR message is received from the TCI server.
-type TXSWRListener interface {
-	SetTXSWR(ratio float64)
-}
-
-func (n *notifier) emitTXSWR(msg Message) error {
-	ratio, err := msg.ToFloat(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(TXSWRListener); ok {
-			listener.SetTXSWR(ratio)
-		}
-	}
-	return nil
-}
-
-// A VolumeListener is notified when a VOLUME message is received from the TCI server.
-type VolumeListener interface {
-	SetVolume(dB int)
-}
-
-func (n *notifier) emitVolume(msg Message) error {
-	dB, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(VolumeListener); ok {
-			listener.SetVolume(dB)
-		}
-	}
-	return nil
-}
-
-// A SquelchEnableListener is notified when a SQL_ENABLE message is received from the TCI server.
-type SquelchEnableListener interface {
-	SetSquelchEnable(trx int, enabled bool)
-}
-
-func (n *notifier) emitSquelchEnable(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	enabled, err := msg.ToBool(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(SquelchEnableListener); ok {
-			listener.SetSquelchEnable(trx, enabled)
-		}
-	}
-	return nil
-}
-
-// A SquelchLevelListener is notified when a SQL_LEVEL message is received from the TCI server.
-type SquelchLevelListener interface {
-	SetSquelchLevel(dB int)
-}
-
-func (n *notifier) emitSquelchLevel(msg Message) error {
-	dB, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(SquelchLevelListener); ok {
-			listener.SetSquelchLevel(dB)
-		}
-	}
-	return nil
-}
-
-// A VFOFrequencyListener is notified when a VFO message is received from the TCI server.
-type VFOFrequencyListener interface {
-	SetVFOFrequency(trx int, vfo VFO, frequency int)
-}
-
-func (n *notifier) emitVFOFrequency(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	vfo, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	frequency, err := msg.ToInt(2)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(VFOFrequencyListener); ok {
-			listener.SetVFOFrequency(trx, VFO(vfo), frequency)
-		}
-	}
-	return nil
-}
-
-// A AppFocusListener is notified when a APP_FOCUS message is received from the TCI server.
-type AppFocusListener interface {
-	SetAppFocus(focussed bool)
-}
-
-func (n *notifier) emitAppFocus(msg Message) error {
-	focussed, err := msg.ToBool(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(AppFocusListener); ok {
-			listener.SetAppFocus(focussed)
-		}
-	}
-	return nil
-}
-
-// A MuteListener is notified when a MUTE message is received from the TCI server.
-type MuteListener interface {
-	SetMute(muted bool)
-}
-
-func (n *notifier) emitMute(msg Message) error {
-	muted, err := msg.ToBool(0)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(MuteListener); ok {
-			listener.SetMute(muted)
-		}
-	}
-	return nil
-}
-
-// A RXMuteListener is notified when a RX_MUTE message is received from the TCI server.
-type RXMuteListener interface {
-	SetRXMute(trx int, muted bool)
-}
-
-func (n *notifier) emitRXMute(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	muted, err := msg.ToBool(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXMuteListener); ok {
-			listener.SetRXMute(trx, muted)
-		}
-	}
-	return nil
-}
-
-// A CTCSSEnableListener is notified when a CTCSS_ENABLE message is received from the TCI server.
-type CTCSSEnableListener interface {
-	SetCTCSSEnable(trx int, enabled bool)
-}
-
-func (n *notifier) emitCTCSSEnable(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	enabled, err := msg.ToBool(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(CTCSSEnableListener); ok {
-			listener.SetCTCSSEnable(trx, enabled)
-		}
-	}
-	return nil
-}
-
-// A CTCSSModeListener is notified when a CTCSS_MODE message is received from the TCI server.
-type CTCSSModeListener interface {
-	SetCTCSSMode(trx int, mode CTCSSMode)
-}
-
-func (n *notifier) emitCTCSSMode(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	mode, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(CTCSSModeListener); ok {
-			listener.SetCTCSSMode(trx, CTCSSMode(mode))
-		}
-	}
-	return nil
-}
-
-// A CTCSSRXToneListener is notified when a CTCSS_RX_TONE message is received from the TCI server.
-type CTCSSRXToneListener interface {
-	SetCTCSSRXTone(trx int, tone CTCSSTone)
-}
-
-func (n *notifier) emitCTCSSRXTone(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	tone, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(CTCSSRXToneListener); ok {
-			listener.SetCTCSSRXTone(trx, CTCSSTone(tone))
-		}
-	}
-	return nil
-}
-
-// A CTCSSTXToneListener is notified when a CTCSS_TX_TONE message is received from the TCI server.
-type CTCSSTXToneListener interface {
-	SetCTCSSTXTone(trx int, tone CTCSSTone)
-}
-
-func (n *notifier) emitCTCSSTXTone(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	tone, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(CTCSSTXToneListener); ok {
-			listener.SetCTCSSTXTone(trx, CTCSSTone(tone))
-		}
-	}
-	return nil
+func (v Version) String() string {
+	return strconv.FormatFloat(float64(v), 'f', -1, 64)
 }
 
-// A CTCSSLevelListener is notified when a CTCSS_LEVEL message is received from the TCI server.
-type CTCSSLevelListener interface {
-	SetCTCSSLevel(trx int, percent int)
-}
+func newNotifier(listeners []interface{}, closed <-chan struct{}) *notifier {
+	result := &notifier{
+		closed:     closed,
+		tciVersion: tci_1_4,
 
-func (n *notifier) emitCTCSSLevel(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
+		logger:             defaultLogger,
+		logUnknownMessages: true,
 	}
-	percent, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(CTCSSLevelListener); ok {
-			listener.SetCTCSSLevel(trx, percent)
-		}
+	for _, listener := range listeners {
+		result.Notify(listener)
 	}
-	return nil
+	return result
 }
 
-// A ECoderSwitchRXListener is notified when a EXODER_SWITCH_RX message is received from the TCI server.
-type ECoderSwitchRXListener interface {
-	SetECoderSwitchRX(ecoder int, trx int)
-}
+type notifier struct {
+	subscriptions subscriptions
+	closed        <-chan struct{}
+	streamStats   streamCounters
+	samplePool    *samplePool
+	tciName       string
+	versionLock   sync.RWMutex
+	tciVersion    Version
 
-func (n *notifier) emitECoderSwitchRX(msg Message) error {
-	ecoder, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	trx, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(ECoderSwitchRXListener); ok {
-			listener.SetECoderSwitchRX(ecoder, trx)
-		}
-	}
-	return nil
+	logger             Logger
+	logUnknownMessages bool
 }
 
-// A ECoderSwitchChannelListener is notified when a ECODER_SWITCH_CHANNEL message is received from the TCI server.
-type ECoderSwitchChannelListener interface {
-	SetECoderSwitchChannel(ecoder int, vfo VFO)
+// Notify registers the given listener. The listener is then notified about incoming messages.
+// Notify is safe to be called concurrently from any goroutine.
+func (n *notifier) Notify(listener interface{}) {
+	n.Subscribe(listener)
 }
 
-func (n *notifier) emitECoderSwitchChannel(msg Message) error {
-	ecoder, err := msg.ToInt(0)
-	if err != nil {
-		return err
+// Subscribe registers the given listener like Notify, and returns a function to remove the listener again.
+// For a compile-time check of the listener's signature, use the typed OnXYZ methods.
+// Each listener is notified in its own goroutine, so a slow listener can neither stall the other listeners nor the
+// replies to the commands. Text messages are never dropped, they are queued until the listener catches up. Binary
+// messages (IQ data, RX audio, TX chrono) are queued separately, see streamDispatcher.
+// A listener that implements none of the listener interfaces is not registered, this is logged as warning.
+func (n *notifier) Subscribe(listener interface{}) Unsubscribe {
+	if !isListener(listener) {
+		n.logger.Warn("listener implements no listener interface", "type", fmt.Sprintf("%T", listener))
+		return func() {}
 	}
-	vfo, err := msg.ToInt(1)
-	if err != nil {
-		return err
+	entry := subscription{listener: listener}
+	if isTextListener(listener) {
+		entry.text = newTextDispatcher(n, listener)
 	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(ECoderSwitchChannelListener); ok {
-			listener.SetECoderSwitchChannel(ecoder, VFO(vfo))
-		}
+	if isStreamListener(listener) {
+		entry.stream = newStreamDispatcher(listener, n.closed, &n.streamStats)
 	}
-	return nil
-}
-
-// A RXVolumeListener is notified when a RX_VOLUME message is received from the TCI server.
-type RXVolumeListener interface {
-	SetRXVolume(trx int, vfo VFO, dB int)
+	return n.subscriptions.add(entry)
 }
 
-func (n *notifier) emitRXVolume(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	vfo, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	dB, err := msg.ToInt(2)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXVolumeListener); ok {
-			listener.SetRXVolume(trx, VFO(vfo), dB)
-		}
-	}
-	return nil
+// subscribeInline registers the given listener, which is notified about text messages directly in the read loop, in
+// the order of their arrival relative to the replies. Only the internal listeners that collect the handshake
+// are registered inline, they must not block.
+func (n *notifier) subscribeInline(listener interface{}) {
+	n.subscriptions.add(subscription{listener: listener, inline: true})
 }
 
-// A RXBalanceListener is notified when a RX_BALANCE message is received from the TCI server.
-type RXBalanceListener interface {
-	SetRXBalance(trx int, vfo VFO, dB int)
+func (n *notifier) listeners() []interface{} {
+	return n.subscriptions.current()
 }
 
-func (n *notifier) emitRXBalance(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
+// textMessage notifies the listeners about an incoming text message. It is called by the read loop: the inline
+// listeners are notified directly, all other listeners are notified by their textDispatcher.
+func (n *notifier) textMessage(msg Message) {
+	if msg.name == "protocol" {
+		n.setTCIProtocol(msg)
 	}
-	vfo, err := msg.ToInt(1)
-	if err != nil {
-		return err
+	known, err := n.handleIncomingMessage(msg, n.subscriptions.currentInline())
+	if !known && n.logUnknownMessages {
+		n.logger.Info("unknown incoming message", "name", msg.Name(), "message", msg.String())
 	}
-	dB, err := msg.ToInt(2)
 	if err != nil {
-		return err
+		n.logger.Warn("cannot emit message", "name", msg.Name(), "message", msg.String(), "error", err)
 	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXBalanceListener); ok {
-			listener.SetRXBalance(trx, VFO(vfo), dB)
-		}
+	for _, dispatcher := range n.subscriptions.currentTextDispatchers() {
+		dispatcher.dispatch(msg)
 	}
-	return nil
-}
-
-// A RXSensorsListener is notified when a RX_SENSORS message is received from the TCI server. (since TCI 1.5)
-type RXSensorsListener interface {
-	SetRXSensors(trx int, dBm float64)
 }
 
-func (n *notifier) emitRXSensors(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	dBm, err := msg.ToFloat(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXSensorsListener); ok {
-			listener.SetRXSensors(trx, dBm)
+// waitForListeners waits until all listeners were notified about the text messages that were received so far, or
+// until the given channel is closed.
+func (n *notifier) waitForListeners(abort <-chan struct{}) {
+	for _, dispatcher := range n.subscriptions.currentTextDispatchers() {
+		select {
+		case <-dispatcher.flush():
+		case <-dispatcher.done:
+		case <-n.closed:
+			return
+		case <-abort:
+			return
 		}
 	}
-	return nil
 }
 
-// A TXSensorsListener is notified when a TX_SENSORS message is received from the TCI server. (since TCI 1.5)
-type TXSensorsListener interface {
-	SetTXSensors(trx int, micdBm float64, txRMS float64, txPeak float64, swr float64)
+// handleIncomingMessage notifies the given listeners about the given text message. It returns false if the message
+// is unknown.
+func (n *notifier) handleIncomingMessage(msg Message, listeners []interface{}) (bool, error) {
+	n.emitMessage(msg, listeners)
+	return n.emitCommand(msg, listeners)
 }
 
-func (n *notifier) emitTXSensors(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	micdBm, err := msg.ToFloat(1)
-	if err != nil {
-		return err
-	}
-	txRMS, err := msg.ToFloat(2)
-	if err != nil {
-		return err
-	}
-	txPeak, err := msg.ToFloat(3)
+func (n *notifier) setTCIProtocol(msg Message) {
+	name, err := msg.ToString(0)
 	if err != nil {
-		return err
+		n.logger.Warn("cannot parse protocol message", "message", msg.String(), "error", err)
+		return
 	}
-	swr, err := msg.ToFloat(4)
+	version, err := msg.ToFloat(1)
 	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(TXSensorsListener); ok {
-			listener.SetTXSensors(trx, micdBm, txRMS, txPeak, swr)
-		}
+		n.logger.Warn("cannot parse protocol version", "message", msg.String(), "error", err)
+		return
 	}
-	return nil
-}
 
-// A RXNBEnableListener is notified when a RX_NB_ENABLE message is received from the TCI server. (since TCI 1.6)
-type RXNBEnableListener interface {
-	SetRXNBEnable(trx int, enabled bool)
+	n.versionLock.Lock()
+	defer n.versionLock.Unlock()
+	n.tciName = name
+	n.tciVersion = Version(version)
 }
 
-func (n *notifier) emitRXNBEnable(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	enabled, err := msg.ToBool(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXNBEnableListener); ok {
-			listener.SetRXNBEnable(trx, enabled)
-		}
-	}
-	return nil
+// resetTCIProtocol restores the defaults until the next PROTOCOL message is received.
+func (n *notifier) resetTCIProtocol() {
+	n.versionLock.Lock()
+	defer n.versionLock.Unlock()
+	n.tciName = ""
+	n.tciVersion = tci_1_4
 }
 
-// A RXNBParamsListener is notified when a RX_NB_PARAM message is received from the TCI server. (since TCI 1.6)
-type RXNBParamsListener interface {
-	SetRXNBParams(trx int, threshold int, impulseLength int)
+// MessageListener is notified when any text message is received from the TCI server.
+type MessageListener interface {
+	Message(msg Message)
 }
 
-func (n *notifier) emitRXNBParams(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	threshold, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	impulseLength, err := msg.ToInt(2)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXNBParamsListener); ok {
-			listener.SetRXNBParams(trx, threshold, impulseLength)
+func (n *notifier) emitMessage(msg Message, listeners []interface{}) {
+	for _, l := range listeners {
+		if listener, ok := l.(MessageListener); ok {
+			listener.Message(msg)
 		}
 	}
-	return nil
 }
 
-// A RXBinEnableListener is notified when a RX_BIN_ENABLE message is received from the TCI server. (since TCI 1.6)
-type RXBinEnableListener interface {
-	SetRXBinEnable(trx int, enabled bool)
+// A ModesListener is notified when a MODULATIONS_LIST message is received from the TCI server.
+type ModesListener interface {
+	SetModes(modes []Mode)
 }
 
-func (n *notifier) emitRXBinEnable(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	enabled, err := msg.ToBool(1)
-	if err != nil {
-		return err
+func (n *notifier) emitModes(msg Message, listeners []interface{}) error {
+	modes := make([]Mode, len(msg.args))
+	for i, arg := range msg.args {
+		modes[i] = Mode(arg)
 	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXBinEnableListener); ok {
-			listener.SetRXBinEnable(trx, enabled)
+	for _, l := range listeners {
+		if listener, ok := l.(ModesListener); ok {
+			listener.SetModes(modes)
 		}
 	}
 	return nil
 }
 
-// A RXNREnableListener is notified when a RX_NR_ENABLE message is received from the TCI server. (since TCI 1.6)
-type RXNREnableListener interface {
-	SetRXNREnable(trx int, enabled bool)
-}
-
-func (n *notifier) emitRXNREnable(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	enabled, err := msg.ToBool(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXNREnableListener); ok {
-			listener.SetRXNREnable(trx, enabled)
-		}
-	}
-	return nil
+// A DriveListener is notified when a DRIVE message is received from the TCI server.
+type DriveListener interface {
+	SetDrive(percent int)
 }
 
-// A RXANCEnableListener is notified when a RX_ANC_ENABLE message is received from the TCI server. (since TCI 1.6)
-type RXANCEnableListener interface {
-	SetRXANCEnable(trx int, enabled bool)
+// A TRXDriveListener is notified when a DRIVE message for a certain TRX is received from the TCI server. (since TCI 1.5)
+type TRXDriveListener interface {
+	SetTRXDrive(trx int, percent int)
 }
 
-func (n *notifier) emitRXANCEnable(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
+func (n *notifier) emitDrive(msg Message, listeners []interface{}) error {
+	if n.version().Beyond(tci_1_4) {
+		return n.emitTRXDrive(msg, listeners)
 	}
-	enabled, err := msg.ToBool(1)
+
+	percent, err := msg.ToInt(0)
 	if err != nil {
 		return err
 	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXANCEnableListener); ok {
-			listener.SetRXANCEnable(trx, enabled)
+	for _, l := range listeners {
+		if listener, ok := l.(DriveListener); ok {
+			listener.SetDrive(percent)
 		}
 	}
 	return nil
 }
 
-// A RXANFEnableListener is notified when a RX_ANF_ENABLE message is received from the TCI server. (since TCI 1.6)
-type RXANFEnableListener interface {
-	SetRXANFEnable(trx int, enabled bool)
-}
-
-func (n *notifier) emitRXANFEnable(msg Message) error {
+func (n *notifier) emitTRXDrive(msg Message, listeners []interface{}) error {
 	trx, err := msg.ToInt(0)
 	if err != nil {
 		return err
 	}
-	enabled, err := msg.ToBool(1)
+	percent, err := msg.ToInt(1)
 	if err != nil {
 		return err
 	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXANFEnableListener); ok {
-			listener.SetRXANFEnable(trx, enabled)
+	for _, l := range listeners {
+		if listener, ok := l.(TRXDriveListener); ok {
+			listener.SetTRXDrive(trx, percent)
+		} else if listener, ok := l.(DriveListener); ok && (trx == 0) {
+			listener.SetDrive(percent)
 		}
 	}
 	return nil
 }
 
-// A RXAPFEnableListener is notified when a RX_APF_ENABLE message is received from the TCI server. (since TCI 1.6)
-type RXAPFEnableListener interface {
-	SetRXAPFEnable(trx int, enabled bool)
+// A TuneDriveListener is notified when a TUNE_DRIVE message is received from the TCI server.
+type TuneDriveListener interface {
+	SetTuneDrive(percent int)
 }
 
-func (n *notifier) emitRXAPFEnable(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
+// A TRXTuneDriveListener is notified when a TUNE_DRIVE message for a certain TRX is received from the TCI server.
+type TRXTuneDriveListener interface {
+	SetTRXTuneDrive(trx int, percent int)
+}
+
+func (n *notifier) emitTuneDrive(msg Message, listeners []interface{}) error {
+	if n.version().Beyond(tci_1_4) {
+		return n.emitTRXTuneDrive(msg, listeners)
 	}
-	enabled, err := msg.ToBool(1)
+
+	percent, err := msg.ToInt(0)
 	if err != nil {
 		return err
 	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXAPFEnableListener); ok {
-			listener.SetRXAPFEnable(trx, enabled)
+	for _, l := range listeners {
+		if listener, ok := l.(TuneDriveListener); ok {
+			listener.SetTuneDrive(percent)
 		}
 	}
 	return nil
 }
 
-// A RXDSEEnableListener is notified when a RX_DSE_ENABLE message is received from the TCI server. (since TCI 1.6)
-type RXDSEEnableListener interface {
-	SetRXDSEEnable(trx int, enabled bool)
-}
-
-func (n *notifier) emitRXDSEEnable(msg Message) error {
+func (n *notifier) emitTRXTuneDrive(msg Message, listeners []interface{}) error {
 	trx, err := msg.ToInt(0)
 	if err != nil {
 		return err
 	}
-	enabled, err := msg.ToBool(1)
+	percent, err := msg.ToInt(1)
 	if err != nil {
 		return err
 	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXDSEEnableListener); ok {
-			listener.SetRXDSEEnable(trx, enabled)
+	for _, l := range listeners {
+		if listener, ok := l.(TRXTuneDriveListener); ok {
+			listener.SetTRXTuneDrive(trx, percent)
+		} else if listener, ok := l.(TuneDriveListener); ok && (trx == 0) {
+			listener.SetTuneDrive(percent)
 		}
 	}
 	return nil
 }
 
-// A RXNFEnableListener is notified when a RX_NF_ENABLE message is received from the TCI server. (since TCI 1.6)
-type RXNFEnableListener interface {
-	SetRXNFEnable(trx int, enabled bool)
-}
+/*
+ * Binary Messages
+ */
 
-func (n *notifier) emitRXNFEnable(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	enabled, err := msg.ToBool(1)
-	if err != nil {
-		return err
+func (n *notifier) binaryMessage(msg BinaryMessage) {
+	switch msg.Type {
+	case IQStreamMessage, RXAudioStreamMessage, TXChronoMessage:
+	default:
+		n.logger.Info("unknown binary message type", "type", msg.Type, "trx", msg.TRX)
 	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXNFEnableListener); ok {
-			listener.SetRXNFEnable(trx, enabled)
-		}
+	for _, dispatcher := range n.subscriptions.currentStreamDispatchers() {
+		dispatcher.dispatch(msg)
 	}
-	return nil
 }
 
-// A TXFrequencyListener is notified when a TX_FREQUENCY message is received from the TCI server. (since TCI 1.6)
-type TXFrequencyListener interface {
-	SetTXFrequency(trx int, frequency int)
+// isListener indicates if the given listener implements at least one of the listener interfaces.
+func isListener(l interface{}) bool {
+	switch l.(type) {
+	case ConnectionListener, ReconnectListener:
+		return true
+	default:
+		return isTextListener(l) || isStreamListener(l)
+	}
 }
 
-func (n *notifier) emitTXFrequency(msg Message) error {
-	trx, err := msg.ToInt(0)
-	if err != nil {
-		return err
-	}
-	frequency, err := msg.ToInt(1)
-	if err != nil {
-		return err
-	}
-	for _, l := range n.listeners {
-		if listener, ok := l.(TXFrequencyListener); ok {
-			listener.SetTXFrequency(trx, frequency)
-		}
+// isTextListener indicates if the given listener implements at least one of the listener interfaces for text messages.
+func isTextListener(l interface{}) bool {
+	switch l.(type) {
+	case MessageListener, ModesListener, DriveListener, TRXDriveListener, TuneDriveListener, TRXTuneDriveListener:
+		return true
+	default:
+		return isCommandListener(l)
 	}
-	return nil
 }
 
-/*
- * Binary Messages
- */
-
-func (n *notifier) binaryMessage(msg BinaryMessage) {
-	n.binaryMessages <- msg
+func isStreamListener(l interface{}) bool {
+	switch l.(type) {
+	case BinaryMessageListener, IQDataListener, RXAudioListener, TXChronoListener:
+		return true
+	default:
+		return false
+	}
 }
 
-func (n *notifier) handleIncomingBinaryMessage(msg BinaryMessage) {
-	n.emitBinaryMessage(msg)
+// handleIncomingBinaryMessage notifies the given listener about the given binary message.
+func handleIncomingBinaryMessage(l interface{}, msg BinaryMessage) {
+	if listener, ok := l.(BinaryMessageListener); ok {
+		listener.BinaryMessage(msg)
+	}
 	switch msg.Type {
 	case IQStreamMessage:
-		n.emitIQData(msg)
+		if listener, ok := l.(IQDataListener); ok {
+			listener.IQData(msg.TRX, IQSampleRate(msg.SampleRate), msg.Data)
+		}
 	case RXAudioStreamMessage:
-		n.emitRXAudio(msg)
+		if listener, ok := l.(RXAudioListener); ok {
+			listener.RXAudio(msg.TRX, AudioSampleRate(msg.SampleRate), msg.Data)
+		}
 	case TXChronoMessage:
-		n.emitTXChrono(msg)
-	default:
-		log.Printf("unknown binary message type: %v", msg.Type)
+		if listener, ok := l.(TXChronoListener); ok {
+			listener.TXChrono(msg.TRX, AudioSampleRate(msg.SampleRate), msg.DataLength)
+		}
 	}
 }
 
 // A BinaryMessageListener is notified when any binary message (IQ data, audio data, or tx chrono) is received from the TCI server.
+// If sample buffer pooling is enabled, the samples are only valid until BinaryMessage returns, see WithSampleBufferPooling.
 type BinaryMessageListener interface {
 	BinaryMessage(msg BinaryMessage)
 }
 
-func (n *notifier) emitBinaryMessage(msg BinaryMessage) {
-	for _, l := range n.listeners {
-		if listener, ok := l.(BinaryMessageListener); ok {
-			listener.BinaryMessage(msg)
-		}
-	}
-}
-
 // A IQDataListener is notified when IQ data is received from the TCI server.
+// If sample buffer pooling is enabled, the data is only valid until IQData returns, see WithSampleBufferPooling.
 type IQDataListener interface {
 	IQData(trx int, sampleRate IQSampleRate, data []float32)
 }
 
-func (n *notifier) emitIQData(msg BinaryMessage) {
-	for _, l := range n.listeners {
-		if listener, ok := l.(IQDataListener); ok {
-			listener.IQData(msg.TRX, IQSampleRate(msg.SampleRate), msg.Data)
-		}
-	}
-}
-
 // A RXAudioListener is notified when RX audio data is received from the TCI server.
+// If sample buffer pooling is enabled, the samples are only valid until RXAudio returns, see WithSampleBufferPooling.
 type RXAudioListener interface {
 	RXAudio(trx int, sampleRate AudioSampleRate, samples []float32)
 }
 
-func (n *notifier) emitRXAudio(msg BinaryMessage) {
-	for _, l := range n.listeners {
-		if listener, ok := l.(RXAudioListener); ok {
-			listener.RXAudio(msg.TRX, AudioSampleRate(msg.SampleRate), msg.Data)
-		}
-	}
-}
-
 // A TXChronoListener is notified when a TX chrono message is received from the TCI server.
 type TXChronoListener interface {
 	TXChrono(trx int, sampleRate AudioSampleRate, requestedSampleCount uint32)
 }
-
-func (n *notifier) emitTXChrono(msg BinaryMessage) {
-	for _, l := range n.listeners {
-		if listener, ok := l.(TXChronoListener); ok {
-			listener.TXChrono(msg.TRX, AudioSampleRate(msg.SampleRate), msg.DataLength)
-		}
-	}
-}
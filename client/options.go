@@ -0,0 +1,118 @@
+package client
+
+import (
+	"crypto/tls"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+)
+
+// Option configures a Client that is created with OpenURL or KeepOpenURL.
+type Option func(*Client)
+
+// WithTrace enables/disables the tracing of the TCI communication. The traced messages are logged with the debug level.
+func WithTrace(trace bool) Option {
+	return func(c *Client) {
+		c.trace = trace
+	}
+}
+
+// WithListeners registers the given listeners, like Notify.
+func WithListeners(listeners ...interface{}) Option {
+	return func(c *Client) {
+		c.initialListeners = append(c.initialListeners, listeners...)
+	}
+}
+
+// WithDialer uses a copy of the given websocket dialer to open the connection, e.g. to use a proxy or a certain handshake timeout.
+func WithDialer(dialer *websocket.Dialer) Option {
+	return func(c *Client) {
+		c.dialer = *dialer
+	}
+}
+
+// WithTLSConfig uses the given TLS configuration for wss:// connections.
+func WithTLSConfig(config *tls.Config) Option {
+	return func(c *Client) {
+		c.dialer.TLSClientConfig = config
+	}
+}
+
+// WithRequestHeader sends the given HTTP header with the websocket handshake request.
+func WithRequestHeader(header http.Header) Option {
+	return func(c *Client) {
+		c.requestHeader = header
+	}
+}
+
+// WithReconnectPolicy defines how KeepOpen and KeepOpenURL retry to connect to the TCI server.
+func WithReconnectPolicy(policy ReconnectPolicy) Option {
+	return func(c *Client) {
+		c.reconnectPolicy = policy
+	}
+}
+
+// WithResyncHook customizes the commands that are sent to restore the streams, sensors, sample rates, and spots
+// when a new connection is established.
+func WithResyncHook(hook ResyncHook) Option {
+	return func(c *Client) {
+		c.resyncHook = hook
+	}
+}
+
+// WithLogger uses the given logger instead of the standard logger of the log package.
+// The traced TCI communication (see WithTrace) is logged with the debug level.
+func WithLogger(logger Logger) Option {
+	return func(c *Client) {
+		c.logger = logger
+	}
+}
+
+// WithUnknownMessageLogging enables/disables the logging of incoming messages that are unknown to the client.
+// It is enabled by default.
+func WithUnknownMessageLogging(enabled bool) Option {
+	return func(c *Client) {
+		c.logUnknownMessages = enabled
+	}
+}
+
+// WithRecorder records every text and binary frame of the TCI communication with the given recorder.
+func WithRecorder(recorder *Recorder) Option {
+	return func(c *Client) {
+		c.recorder = recorder
+	}
+}
+
+// WithStrictCommands enables/disables the strict command mode. In strict mode, commands that are not echoed by the
+// TCI server within the timeout fail with ErrNoEcho. Otherwise, they are considered successful, because the TCI
+// server does not echo all commands.
+func WithStrictCommands(strict bool) Option {
+	return func(c *Client) {
+		c.strictCommands = strict
+	}
+}
+
+// WithDeviceLimits enables/disables the validation of command arguments against the limits of the connected device,
+// which are collected in the DeviceInfo. With validation enabled, commands with out-of-range frequencies, nonexistent
+// TRX or VFO indexes, unsupported modes, or TX on a receive-only device fail with an *ArgumentError or a *DeviceError
+// before they are sent. Limits that were not announced by the TCI server are not checked. It is disabled by default.
+func WithDeviceLimits(enabled bool) Option {
+	return func(c *Client) {
+		c.deviceLimits = enabled
+	}
+}
+
+// WithSampleBufferPooling enables/disables the pooling of the sample buffers of incoming IQ data and RX audio.
+// It is disabled by default. With pooling enabled, the samples that are passed to IQDataListener, RXAudioListener,
+// and BinaryMessageListener are only valid until the listener returns. The buffer is then released and reused for
+// the next binary message. Listeners that need the samples for longer must copy them. The events of an EventStream
+// carry copies of the samples.
+func WithSampleBufferPooling(enabled bool) Option {
+	return func(c *Client) {
+		if enabled {
+			c.samplePool = new(samplePool)
+		} else {
+			c.samplePool = nil
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// failingConn fails to write any message.
+type failingConn struct{}
+
+func (failingConn) RemoteAddr() net.Addr              { return &net.TCPAddr{} }
+func (failingConn) Close() error                      { return nil }
+func (failingConn) WriteMessage(int, []byte) error    { return errors.New("broken pipe") }
+func (failingConn) ReadMessage() (int, []byte, error) { return 0, nil, errors.New("closed") }
+
+func TestWriteCommand_WriteErrorCompletesCommand(t *testing.T) {
+	c := newClient("ws://localhost:40001", nil)
+	cmd := command{
+		Message: NewCommandMessage("vfo", 0, 0, 7012000),
+		ctx:     context.Background(),
+		reply:   make(chan reply, 1),
+	}
+
+	pending := c.writeCommand(failingConn{}, cmd, nil)
+
+	assert.Empty(t, pending)
+	select {
+	case r := <-cmd.reply:
+		assert.ErrorIs(t, r.err, ErrDisconnected)
+	default:
+		require.Fail(t, "the command was not completed")
+	}
+}
@@ -0,0 +1,70 @@
+package client
+
+import (
+	"sync"
+	"sync/atomic"
+)
+
+// samplePool recycles the sample buffers of incoming binary messages, see WithSampleBufferPooling.
+type samplePool struct {
+	pool sync.Pool
+}
+
+// pooledSamples is a reference counted sample buffer. It returns to its pool when the last reference is released.
+type pooledSamples struct {
+	data []float32
+	refs atomic.Int32
+	pool *samplePool
+}
+
+// get returns a buffer from the pool that is referenced once.
+func (p *samplePool) get() *pooledSamples {
+	result, _ := p.pool.Get().(*pooledSamples)
+	if result == nil {
+		result = &pooledSamples{pool: p}
+	}
+	result.refs.Store(1)
+	return result
+}
+
+// parse parses the given binary message into a pooled buffer. The caller owns one reference to the buffer of the
+// resulting message and must release it.
+func (p *samplePool) parse(b []byte) (BinaryMessage, error) {
+	samples := p.get()
+	result, err := ParseBinaryMessageInto(b, samples.data)
+	if err != nil {
+		samples.release()
+		return BinaryMessage{}, err
+	}
+	if cap(result.Data) > cap(samples.data) {
+		samples.data = result.Data
+	}
+	result.samples = samples
+	return result, nil
+}
+
+func (s *pooledSamples) retain() {
+	if s == nil {
+		return
+	}
+	s.refs.Add(1)
+}
+
+func (s *pooledSamples) release() {
+	if s == nil {
+		return
+	}
+	if s.refs.Add(-1) == 0 {
+		s.pool.pool.Put(s)
+	}
+}
+
+// retain adds a reference to the pooled buffer of this message. It does nothing if the message is not pooled.
+func (m BinaryMessage) retain() {
+	m.samples.retain()
+}
+
+// release removes a reference from the pooled buffer of this message. It does nothing if the message is not pooled.
+func (m BinaryMessage) release() {
+	m.samples.release()
+}
@@ -0,0 +1,110 @@
+package client
+
+import (
+	"math"
+	"math/rand"
+	"time"
+)
+
+// ReconnectPolicy defines how KeepOpen and KeepOpenURL retry to connect to the TCI server.
+// The delay before the n-th attempt is InitialDelay * Multiplier^(n-1), limited to MaxDelay, and randomized by Jitter.
+type ReconnectPolicy struct {
+	// InitialDelay is the delay before the first retry.
+	InitialDelay time.Duration
+	// MaxDelay limits the delay between two attempts. Zero means no limit.
+	MaxDelay time.Duration
+	// Multiplier is applied to the delay after each failed attempt. Values below 1 are treated as 1.
+	Multiplier float64
+	// Jitter randomizes each delay by the given fraction, e.g. 0.2 means +/- 20%. Zero disables the jitter.
+	Jitter float64
+	// MaxAttempts is the number of failed attempts after which the client gives up. Zero means no limit.
+	MaxAttempts int
+}
+
+// DefaultReconnectPolicy is used by KeepOpenURL if no other policy is configured with WithReconnectPolicy.
+var DefaultReconnectPolicy = ReconnectPolicy{
+	InitialDelay: 1 * time.Second,
+	MaxDelay:     1 * time.Minute,
+	Multiplier:   2,
+	Jitter:       0.2,
+}
+
+// FixedReconnectPolicy returns a policy that retries to connect forever with the given interval.
+func FixedReconnectPolicy(interval time.Duration) ReconnectPolicy {
+	return ReconnectPolicy{
+		InitialDelay: interval,
+		MaxDelay:     interval,
+		Multiplier:   1,
+	}
+}
+
+// Delay returns the delay before the given retry attempt, starting with 1.
+func (p ReconnectPolicy) Delay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	if p.InitialDelay <= 0 {
+		return 0
+	}
+	multiplier := math.Max(p.Multiplier, 1)
+	delay := float64(p.InitialDelay) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxDelay > 0 && delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+	// without MaxDelay, the delay grows beyond the range of time.Duration after enough attempts
+	if delay > maxReconnectDelay {
+		delay = maxReconnectDelay
+	}
+	if p.Jitter > 0 {
+		delay += delay * p.Jitter * (2*rand.Float64() - 1)
+	}
+	if delay < 0 {
+		return 0
+	}
+	if delay > maxReconnectDelay {
+		delay = maxReconnectDelay
+	}
+	return time.Duration(delay)
+}
+
+// maxReconnectDelay is the largest float64 value that fits into a time.Duration. float64(math.MaxInt64) is rounded up
+// to 2^63, which would overflow.
+const maxReconnectDelay = float64(math.MaxInt64 - 1023)
+
+// GiveUp indicates if the client should stop trying to connect after the given number of failed attempts.
+func (p ReconnectPolicy) GiveUp(failedAttempts int) bool {
+	return p.MaxAttempts > 0 && failedAttempts >= p.MaxAttempts
+}
+
+// ReconnectAttempt describes a failed attempt to connect to the TCI server.
+type ReconnectAttempt struct {
+	// Attempt is the number of the failed attempt since the last established connection, starting with 1.
+	Attempt int
+	// Err is the reason why the attempt failed.
+	Err error
+	// NextRetry is the delay until the next attempt. It is zero if GaveUp is true.
+	NextRetry time.Duration
+	// GaveUp indicates that the client stopped trying to connect, because the policy's MaxAttempts is reached.
+	GaveUp bool
+}
+
+// ReconnectListener is notified about each failed attempt to connect to the TCI server when using KeepOpen or KeepOpenURL.
+type ReconnectListener interface {
+	ReconnectFailed(attempt ReconnectAttempt)
+}
+
+// ReconnectListenerFunc wraps a function with the ReconnectListener interface.
+type ReconnectListenerFunc func(ReconnectAttempt)
+
+// ReconnectFailed implements the ReconnectListener interface.
+func (f ReconnectListenerFunc) ReconnectFailed(attempt ReconnectAttempt) {
+	f(attempt)
+}
+
+func (c *Client) emitReconnectFailed(attempt ReconnectAttempt) {
+	for _, l := range c.listeners() {
+		if listener, ok := l.(ReconnectListener); ok {
+			listener.ReconnectFailed(attempt)
+		}
+	}
+}
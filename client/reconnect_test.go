@@ -0,0 +1,90 @@
+package client_test
+
+import (
+	"math"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ftl/tci/client"
+)
+
+func TestReconnectPolicy_Delay(t *testing.T) {
+	policy := client.ReconnectPolicy{
+		InitialDelay: time.Second,
+		MaxDelay:     10 * time.Second,
+		Multiplier:   2,
+	}
+
+	assert.Equal(t, time.Second, policy.Delay(1))
+	assert.Equal(t, 2*time.Second, policy.Delay(2))
+	assert.Equal(t, 8*time.Second, policy.Delay(4))
+	assert.Equal(t, 10*time.Second, policy.Delay(5))
+	assert.Equal(t, 10*time.Second, policy.Delay(100))
+}
+
+func TestReconnectPolicy_DelayWithoutLimit(t *testing.T) {
+	policy := client.ReconnectPolicy{
+		InitialDelay: time.Second,
+		Multiplier:   2,
+	}
+
+	assert.Equal(t, time.Duration(math.MaxInt64-1023), policy.Delay(1000))
+
+	policy.Jitter = 0.2
+	for i := 0; i < 100; i++ {
+		assert.Greater(t, policy.Delay(1000), 100*365*24*time.Hour)
+	}
+}
+
+func TestReconnectPolicy_Jitter(t *testing.T) {
+	policy := client.ReconnectPolicy{
+		InitialDelay: time.Second,
+		Multiplier:   1,
+		Jitter:       0.5,
+	}
+
+	for i := 0; i < 100; i++ {
+		delay := policy.Delay(1)
+		assert.GreaterOrEqual(t, delay, 500*time.Millisecond)
+		assert.LessOrEqual(t, delay, 1500*time.Millisecond)
+	}
+}
+
+func TestReconnectPolicy_GiveUp(t *testing.T) {
+	assert.False(t, client.ReconnectPolicy{}.GiveUp(1000))
+	assert.False(t, client.ReconnectPolicy{MaxAttempts: 3}.GiveUp(2))
+	assert.True(t, client.ReconnectPolicy{MaxAttempts: 3}.GiveUp(3))
+}
+
+func TestKeepOpenURL_ReportsFailedAttempts(t *testing.T) {
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	address := listener.Addr().String()
+	listener.Close()
+
+	attempts := make(chan client.ReconnectAttempt, 3)
+	c, err := client.KeepOpenURL(address,
+		client.WithReconnectPolicy(client.ReconnectPolicy{InitialDelay: time.Millisecond, Multiplier: 2, MaxAttempts: 3}),
+		client.WithListeners(client.ReconnectListenerFunc(func(attempt client.ReconnectAttempt) {
+			attempts <- attempt
+		})),
+	)
+	require.NoError(t, err)
+	defer c.Disconnect()
+
+	for i := 1; i <= 3; i++ {
+		attempt := <-attempts
+		assert.Equal(t, i, attempt.Attempt)
+		assert.Error(t, attempt.Err)
+		assert.Equal(t, i == 3, attempt.GaveUp)
+		if i < 3 {
+			assert.Equal(t, time.Duration(1<<(i-1))*time.Millisecond, attempt.NextRetry)
+		} else {
+			assert.Zero(t, attempt.NextRetry)
+		}
+	}
+}
@@ -0,0 +1,185 @@
+package client
+
+import (
+	"bufio"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Direction is the direction of a recorded frame.
+type Direction string
+
+// All directions of recorded frames.
+const (
+	// Incoming frames were received from the TCI server.
+	Incoming Direction = "<"
+	// Outgoing frames were sent to the TCI server.
+	Outgoing Direction = ">"
+)
+
+// Frame is a single websocket frame of the TCI communication, as it is written by a Recorder.
+type Frame struct {
+	Time      time.Time
+	Direction Direction
+	Binary    bool
+	Data      []byte
+}
+
+// Recorder writes every text and binary frame of the TCI communication to an io.Writer. Use WithRecorder to
+// record the communication of a Client.
+//
+// The recording contains one frame per line: the timestamp (RFC 3339 with nanoseconds), the direction (< or >),
+// the frame type (text or binary), and the payload. The payload of text frames is a quoted Go string, the payload of
+// binary frames is encoded with standard base64. Empty lines and lines that start with # are ignored.
+type Recorder struct {
+	lock sync.Mutex
+	w    io.Writer
+	err  error
+}
+
+// NewRecorder returns a new Recorder that writes to the given writer.
+func NewRecorder(w io.Writer) *Recorder {
+	return &Recorder{w: w}
+}
+
+// Record writes the given frame. After the first error, all subsequent calls return this error.
+func (r *Recorder) Record(frame Frame) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	_, r.err = io.WriteString(r.w, formatFrame(frame)+"\n")
+	return r.err
+}
+
+// Err returns the first error that occurred while writing the recording.
+func (r *Recorder) Err() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.err
+}
+
+func formatFrame(frame Frame) string {
+	var frameType, payload string
+	if frame.Binary {
+		frameType = "binary"
+		payload = base64.StdEncoding.EncodeToString(frame.Data)
+	} else {
+		frameType = "text"
+		payload = strconv.Quote(string(frame.Data))
+	}
+	return fmt.Sprintf("%s %s %s %s", frame.Time.Format(time.RFC3339Nano), frame.Direction, frameType, payload)
+}
+
+// ReadRecording reads all frames from the given recording.
+func ReadRecording(r io.Reader) ([]Frame, error) {
+	var result []Frame
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(nil, 16*1024*1024)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		frame, err := parseFrame(text)
+		if err != nil {
+			return nil, fmt.Errorf("invalid frame in line %d: %w", line, err)
+		}
+		result = append(result, frame)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func parseFrame(s string) (Frame, error) {
+	fields := strings.SplitN(s, " ", 4)
+	if len(fields) != 4 {
+		return Frame{}, errors.New("expected timestamp, direction, type, and payload")
+	}
+
+	var result Frame
+	var err error
+	result.Time, err = time.Parse(time.RFC3339Nano, fields[0])
+	if err != nil {
+		return Frame{}, fmt.Errorf("invalid timestamp: %w", err)
+	}
+
+	switch Direction(fields[1]) {
+	case Incoming, Outgoing:
+		result.Direction = Direction(fields[1])
+	default:
+		return Frame{}, fmt.Errorf("invalid direction %q", fields[1])
+	}
+
+	switch fields[2] {
+	case "text":
+		var payload string
+		payload, err = strconv.Unquote(fields[3])
+		result.Data = []byte(payload)
+	case "binary":
+		result.Binary = true
+		result.Data, err = base64.StdEncoding.DecodeString(fields[3])
+	default:
+		return Frame{}, fmt.Errorf("invalid frame type %q", fields[2])
+	}
+	if err != nil {
+		return Frame{}, fmt.Errorf("invalid payload: %w", err)
+	}
+
+	return result, nil
+}
+
+// recordingConn records all frames that are read from and written to the wrapped connection.
+type recordingConn struct {
+	clientConn
+	recorder *Recorder
+	logger   Logger
+	failed   sync.Once
+}
+
+func (c *recordingConn) ReadMessage() (int, []byte, error) {
+	messageType, data, err := c.clientConn.ReadMessage()
+	if err == nil {
+		c.record(Incoming, messageType, data)
+	}
+	return messageType, data, err
+}
+
+func (c *recordingConn) WriteMessage(messageType int, data []byte) error {
+	err := c.clientConn.WriteMessage(messageType, data)
+	if err == nil {
+		c.record(Outgoing, messageType, data)
+	}
+	return err
+}
+
+func (c *recordingConn) record(direction Direction, messageType int, data []byte) {
+	if messageType != websocket.TextMessage && messageType != websocket.BinaryMessage {
+		return
+	}
+	frame := Frame{
+		Time:      time.Now(),
+		Direction: direction,
+		Binary:    messageType == websocket.BinaryMessage,
+		Data:      append([]byte(nil), data...),
+	}
+	err := c.recorder.Record(frame)
+	if err != nil {
+		c.failed.Do(func() {
+			c.logger.Error("cannot record frames", "error", err)
+		})
+	}
+}
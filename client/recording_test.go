@@ -0,0 +1,102 @@
+package client_test
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ftl/tci/client"
+	"github.com/ftl/tci/client/clienttest"
+)
+
+func TestRecording_RoundTrip(t *testing.T) {
+	now := time.Date(2026, 10, 16, 12, 0, 0, 123456789, time.UTC)
+	frames := []client.Frame{
+		{Time: now, Direction: client.Incoming, Data: []byte("protocol:ExpertSDR3,1.8;")},
+		{Time: now.Add(time.Millisecond), Direction: client.Outgoing, Data: []byte("spot:DL1ABC,cw,7010000,0,\"quoted\" text\n;")},
+		{Time: now.Add(2 * time.Millisecond), Direction: client.Incoming, Binary: true, Data: []byte{0, 1, 2, 255}},
+	}
+	buffer := new(bytes.Buffer)
+	recorder := client.NewRecorder(buffer)
+	for _, frame := range frames {
+		require.NoError(t, recorder.Record(frame))
+	}
+
+	assert.Equal(t, "2026-10-16T12:00:00.123456789Z < text \"protocol:ExpertSDR3,1.8;\"\n", strings.SplitAfter(buffer.String(), "\n")[0])
+
+	actual, err := client.ReadRecording(strings.NewReader("# recorded in the field\n\n" + buffer.String()))
+	require.NoError(t, err)
+	assert.Equal(t, frames, actual)
+}
+
+func TestReadRecording_InvalidFrame(t *testing.T) {
+	_, err := client.ReadRecording(strings.NewReader("2026-10-16T12:00:00Z < text \"ready;\"\n2026-10-16T12:00:00Z ? text \"ready;\"\n"))
+	assert.ErrorContains(t, err, "line 2")
+}
+
+type syncBuffer struct {
+	lock   sync.Mutex
+	buffer bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buffer.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.buffer.String()
+}
+
+func TestRecordAndReplay(t *testing.T) {
+	buffer := new(syncBuffer)
+	server := clienttest.NewServer()
+	defer server.Close()
+	c, err := client.OpenURL(server.URL(), client.WithRecorder(client.NewRecorder(buffer)))
+	require.NoError(t, err)
+	require.NoError(t, c.SetVFOFrequency(0, client.VFOA, 7020000))
+	require.NoError(t, server.SendIQData(0, client.IQSampleRate48k, []float32{0.5, -0.5}))
+	require.NoError(t, server.Send(client.NewCommandMessage("volume", -30)))
+	require.Eventually(t, func() bool {
+		return strings.Contains(buffer.String(), "volume:-30;")
+	}, time.Second, time.Millisecond)
+	c.Disconnect()
+
+	frames, err := client.ReadRecording(strings.NewReader(buffer.String()))
+	require.NoError(t, err)
+	assert.Equal(t, client.Outgoing, frames[len(clienttest.DefaultHandshake())].Direction)
+
+	listener := &streamListener{iq: make(chan []float32, 1)}
+	volume := make(chan int, 10)
+	replayed, err := client.Replay(frames, 0, client.WithListeners(listener, client.VolumeListenerFunc(func(dB int) { volume <- dB })))
+	require.NoError(t, err)
+	defer replayed.Disconnect()
+
+	assert.Equal(t, "SunSDR2PRO", replayed.DeviceInfo().DeviceName)
+	assert.Equal(t, []float32{0.5, -0.5}, <-listener.iq)
+	assert.Equal(t, -10, <-volume)
+	assert.Equal(t, -30, <-volume)
+}
+
+func TestReplayServer(t *testing.T) {
+	frames := []client.Frame{}
+	for _, msg := range clienttest.DefaultHandshake() {
+		frames = append(frames, client.Frame{Direction: client.Incoming, Data: []byte(msg.String())})
+	}
+	server := clienttest.NewReplayServer(frames, 1)
+	defer server.Close()
+
+	c, err := client.OpenURL(server.URL())
+	require.NoError(t, err)
+	defer c.Disconnect()
+
+	assert.Equal(t, "SunSDR2PRO", c.DeviceInfo().DeviceName)
+}
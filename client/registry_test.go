@@ -0,0 +1,338 @@
+package client_test
+
+import (
+	"context"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ftl/tci/client"
+	"github.com/ftl/tci/client/clienttest"
+	"github.com/ftl/tci/client/internal/registry"
+)
+
+var (
+	clientType  = reflect.TypeOf(&client.Client{})
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+func TestRegistry_ClientMethods(t *testing.T) {
+	for _, command := range registry.Commands {
+		command := command
+		if command.Setter != "" {
+			t.Run(command.Setter, func(t *testing.T) {
+				method, ok := clientType.MethodByName(command.Setter)
+				require.True(t, ok, "method missing")
+				contextMethod, ok := clientType.MethodByName(command.Setter + "Context")
+				require.True(t, ok, "context variant missing")
+				assert.Equal(t, contextType, contextMethod.Type.In(1))
+				if !command.Is(registry.ManualSetter) {
+					assertSignature(t, method.Type, command.Args, nil)
+				}
+			})
+		}
+		if command.Getter != "" {
+			t.Run(command.Getter, func(t *testing.T) {
+				method, ok := clientType.MethodByName(command.Getter)
+				require.True(t, ok, "method missing")
+				contextMethod, ok := clientType.MethodByName(command.Getter + "Context")
+				require.True(t, ok, "context variant missing")
+				assert.Equal(t, contextType, contextMethod.Type.In(1))
+				if !command.Is(registry.ManualGetter) {
+					assertSignature(t, method.Type, command.Selectors(), command.Values())
+				}
+			})
+		}
+		if command.HasListener() {
+			t.Run("On"+command.Method, func(t *testing.T) {
+				method, ok := clientType.MethodByName("On" + command.Method)
+				require.True(t, ok, "method missing")
+				if command.Is(registry.ManualListener) {
+					return
+				}
+				listenerType := method.Type.In(1)
+				require.Equal(t, len(command.Args), listenerType.NumIn())
+				for i, arg := range command.Args {
+					assert.Equal(t, arg.Type, typeName(listenerType.In(i)), arg.Name)
+				}
+			})
+		}
+	}
+}
+
+// assertSignature checks that the given method type has the given parameters (after the receiver) and returns
+// the given results followed by an error.
+func assertSignature(t *testing.T, method reflect.Type, params []registry.Arg, results []registry.Arg) {
+	t.Helper()
+	require.Equal(t, len(params)+1, method.NumIn(), "parameter count")
+	for i, param := range params {
+		assert.Equal(t, param.Type, typeName(method.In(i+1)), param.Name)
+	}
+	require.Equal(t, len(results)+1, method.NumOut(), "result count")
+	for i, result := range results {
+		assert.Equal(t, result.Type, typeName(method.Out(i)), result.Name)
+	}
+	assert.Equal(t, errorType, method.Out(len(results)))
+}
+
+func typeName(t reflect.Type) string {
+	if t.Kind() == reflect.Slice {
+		return "[]" + t.Elem().Name()
+	}
+	return t.Name()
+}
+
+// sampleValue returns a distinguishable example value of the given type.
+func sampleValue(t reflect.Type, i int) reflect.Value {
+	result := reflect.New(t).Elem()
+	switch {
+	case t == reflect.TypeOf(client.SampleTypeInt24):
+		result.Set(reflect.ValueOf(client.SampleTypeInt24))
+	case t == reflect.TypeOf(client.ModeUSB):
+		result.Set(reflect.ValueOf(client.ModeUSB))
+	case t.Kind() == reflect.Int:
+		result.SetInt(int64(i + 1))
+	case t.Kind() == reflect.Bool:
+		result.SetBool(true)
+	case t.Kind() == reflect.Float64:
+		result.SetFloat(float64(i) + 0.5)
+	case t.Kind() == reflect.String:
+		result.SetString("text")
+	default:
+		panic("no sample value for " + t.String())
+	}
+	return result
+}
+
+func interfaces(values []reflect.Value) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		result[i] = v.Interface()
+	}
+	return result
+}
+
+func TestRegistry_GettersReadTheValuesAtTheReplyIndex(t *testing.T) {
+	for _, command := range registry.Commands {
+		command := command
+		if command.Getter == "" || command.Is(registry.ManualGetter) {
+			continue
+		}
+		t.Run(command.Getter, func(t *testing.T) {
+			server, c := openTestClient(t)
+			method := reflect.ValueOf(c).MethodByName(command.Getter)
+
+			args := make([]reflect.Value, 0, method.Type().NumIn())
+			for i := 0; i < method.Type().NumIn(); i++ {
+				args = append(args, sampleValue(method.Type().In(i), i))
+			}
+			values := make([]reflect.Value, 0, method.Type().NumOut()-1)
+			for i := 0; i < method.Type().NumOut()-1; i++ {
+				values = append(values, sampleValue(method.Type().Out(i), len(args)+i))
+			}
+			sendAndWait(t, server, c, client.NewCommandMessage(command.Name, append(interfaces(args), interfaces(values)...)...))
+
+			results := method.Call(args)
+			require.Nil(t, results[len(results)-1].Interface())
+			assert.Equal(t, interfaces(values), interfaces(results[:len(results)-1]))
+			request := server.Received()[0]
+			assert.Equal(t, len(args), len(request.Args()), "request arguments")
+		})
+	}
+}
+
+// sendAndWait sends the given message to the client and waits until the client received it. Otherwise the message
+// might be taken as reply to a following request.
+func sendAndWait(t *testing.T, server *clienttest.Server, c *client.Client, msg client.Message) {
+	t.Helper()
+	received := make(chan struct{})
+	var once sync.Once
+	unsubscribe := c.OnMessage(func(m client.Message) {
+		if m.String() == msg.String() {
+			once.Do(func() { close(received) })
+		}
+	})
+	defer unsubscribe()
+
+	require.NoError(t, server.Send(msg))
+	select {
+	case <-received:
+	case <-time.After(time.Second):
+		t.Fatal("message was not received")
+	}
+}
+
+func TestRegistry_ListenersReadAllArguments(t *testing.T) {
+	for _, command := range registry.Commands {
+		command := command
+		if !command.HasListener() || command.Is(registry.ManualListener) {
+			continue
+		}
+		t.Run(command.Listener(), func(t *testing.T) {
+			server, c := openTestClient(t)
+			subscribe := reflect.ValueOf(c).MethodByName("On" + command.Method)
+			listenerType := subscribe.Type().In(0)
+
+			received := make(chan []interface{}, 1)
+			listener := reflect.MakeFunc(listenerType, func(args []reflect.Value) []reflect.Value {
+				received <- interfaces(args)
+				return nil
+			})
+			subscribe.Call([]reflect.Value{listener})
+
+			values := make([]reflect.Value, listenerType.NumIn())
+			for i := range values {
+				values[i] = sampleValue(listenerType.In(i), i)
+			}
+			require.NoError(t, server.Send(client.NewCommandMessage(command.Name, interfaces(values)...)))
+
+			select {
+			case args := <-received:
+				assert.Equal(t, interfaces(values), args)
+			case <-time.After(time.Second):
+				t.Fatal("listener was not notified")
+			}
+		})
+	}
+}
+
+func TestDrive_UsesTRXZeroSinceTCI1_5(t *testing.T) {
+	server, c := openTestClient(t)
+	sendAndWait(t, server, c, client.NewCommandMessage("drive", 0, 42))
+
+	percent, err := c.Drive()
+	require.NoError(t, err)
+	assert.Equal(t, 42, percent)
+
+	require.NoError(t, c.SetTuneDrive(15))
+	percent, err = c.TuneDrive()
+	require.NoError(t, err)
+	assert.Equal(t, 15, percent)
+	assert.Equal(t, []string{"0", "15"}, server.Received()[1].Args())
+}
+
+func TestDrive_TCI1_4(t *testing.T) {
+	handshake := clienttest.DefaultHandshake()
+	handshake[0] = client.NewCommandMessage("protocol", "ExpertSDR2", "1.4")
+	for i, msg := range handshake {
+		if msg.Name() == "drive" && msg.Args()[0] == "0" {
+			handshake[i] = client.NewCommandMessage("drive", 30)
+		}
+	}
+	server := clienttest.NewServer(handshake...)
+	defer server.Close()
+	c, err := client.OpenURL(server.URL())
+	require.NoError(t, err)
+	defer c.Disconnect()
+
+	percent, err := c.Drive()
+	require.NoError(t, err)
+	assert.Equal(t, 30, percent)
+	assert.Empty(t, server.Received()[0].Args())
+}
+
+// exampleArguments contains an example value for each argument type in the registry.
+var exampleArguments = map[string]interface{}{
+	"int":             1,
+	"bool":            true,
+	"float64":         1.5,
+	"string":          "text",
+	"Mode":            client.ModeUSB,
+	"[]Mode":          client.ModeUSB,
+	"AGCMode":         client.AGCModeFast,
+	"VFO":             client.VFOB,
+	"SampleType":      client.SampleTypeInt16,
+	"IQSampleRate":    client.IQSampleRate48k,
+	"AudioSampleRate": client.AudioSampleRate48k,
+	"CTCSSMode":       client.CTCSSModeBoth,
+	"CTCSSTone":       1,
+	"ARGB":            client.NewARGB(255, 0, 0, 0),
+}
+
+func TestRegistry_EventsCoverAllMessages(t *testing.T) {
+	server, c := openTestClient(t)
+	stream := c.Events(client.EventStreamConfig{BufferSize: len(registry.Commands)})
+	defer stream.Close()
+
+	expected := make(map[string]bool)
+	for _, command := range registry.Commands {
+		if !command.HasListener() {
+			continue
+		}
+		args := make([]interface{}, len(command.Args))
+		for i, arg := range command.Args {
+			example, ok := exampleArguments[arg.Type]
+			require.True(t, ok, "%s: no example for %s", command.Name, arg.Type)
+			args[i] = example
+		}
+		expected[command.Name] = true
+		require.NoError(t, server.Send(client.NewCommandMessage(command.Name, args...)))
+	}
+
+	timeout := time.After(time.Second)
+	for len(expected) > 0 {
+		select {
+		case event := <-stream.C:
+			delete(expected, event.EventName())
+		case <-timeout:
+			t.Fatalf("no events for %v", expected)
+		}
+	}
+}
+
+func TestRegistry_CommandsBeyondTCI1_7(t *testing.T) {
+	handshake := clienttest.DefaultHandshake()
+	handshake[0] = client.NewCommandMessage("protocol", "ExpertSDR3", "1.7")
+	server := clienttest.NewServer(handshake...)
+	defer server.Close()
+	c, err := client.OpenURL(server.URL())
+	require.NoError(t, err)
+	defer c.Disconnect()
+	c.SetTimeout(50 * time.Millisecond)
+
+	tested := 0
+	sent := 0
+	for _, command := range registry.Commands {
+		if command.Version() <= 1.7 {
+			continue
+		}
+		command := command
+		for _, name := range []string{command.Setter, command.Getter} {
+			if name == "" {
+				continue
+			}
+			tested++
+			if !command.Verified() {
+				sent++
+			}
+			t.Run(name, func(t *testing.T) {
+				method := reflect.ValueOf(c).MethodByName(name)
+				args := make([]reflect.Value, method.Type().NumIn())
+				for i := range args {
+					args[i] = sampleValue(method.Type().In(i), i)
+					if name == command.Setter && i < len(command.Args) && command.Args[i].Limited {
+						// out of range, the ranges of unverified commands are not checked
+						args[i] = reflect.ValueOf(command.Args[i].Min - 1).Convert(method.Type().In(i))
+					}
+				}
+
+				results := method.Call(args)
+
+				err, _ := results[len(results)-1].Interface().(error)
+				if command.Verified() {
+					assert.ErrorIs(t, err, client.ErrUnsupported)
+				} else {
+					assert.NotErrorIs(t, err, client.ErrUnsupported)
+					assert.NotErrorIs(t, err, client.ErrArgumentOutOfRange)
+				}
+			})
+		}
+	}
+	assert.NotZero(t, tested)
+	assert.Len(t, server.Received(), sent)
+}
@@ -0,0 +1,112 @@
+package client
+
+import (
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Replay returns a Client that receives the incoming frames of the given recording instead of connecting to
+// a TCI server. Outgoing messages are discarded. The speed defines how the time between the recorded frames is
+// reproduced: 1 replays in real time, 2 twice as fast, 0 replays all frames as fast as possible.
+// Replay returns as soon as the READY; message of the recording was received. After the last frame was
+// replayed, the client stays connected until Disconnect is called.
+func Replay(frames []Frame, speed float64, options ...Option) (*Client, error) {
+	client := newClient("replay", options)
+	client.dial = func() (clientConn, error) {
+		return newReplayConn(frames, speed), nil
+	}
+	err := client.connect()
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// ReplayDelay returns the delay before replaying the given frame after the previous frame with the given speed.
+func ReplayDelay(previous, next Frame, speed float64) time.Duration {
+	if speed <= 0 || previous.Time.IsZero() {
+		return 0
+	}
+	delay := next.Time.Sub(previous.Time)
+	if delay < 0 {
+		return 0
+	}
+	return time.Duration(float64(delay) / speed)
+}
+
+var errReplayConnClosed = errors.New("replay connection closed")
+
+// replayConn implements the clientConn interface for a recording.
+type replayConn struct {
+	frames    []Frame
+	speed     float64
+	previous  Frame
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newReplayConn(frames []Frame, speed float64) *replayConn {
+	return &replayConn{
+		frames: frames,
+		speed:  speed,
+		closed: make(chan struct{}),
+	}
+}
+
+func (c *replayConn) RemoteAddr() net.Addr {
+	return replayAddr{}
+}
+
+func (c *replayConn) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+	})
+	return nil
+}
+
+func (c *replayConn) WriteMessage(int, []byte) error {
+	select {
+	case <-c.closed:
+		return errReplayConnClosed
+	default:
+		return nil
+	}
+}
+
+func (c *replayConn) ReadMessage() (int, []byte, error) {
+	for len(c.frames) > 0 {
+		frame := c.frames[0]
+		c.frames = c.frames[1:]
+		if frame.Direction != Incoming {
+			continue
+		}
+
+		delay := ReplayDelay(c.previous, frame, c.speed)
+		c.previous = frame
+		if delay > 0 {
+			select {
+			case <-time.After(delay):
+			case <-c.closed:
+				return 0, nil, errReplayConnClosed
+			}
+		}
+
+		messageType := websocket.TextMessage
+		if frame.Binary {
+			messageType = websocket.BinaryMessage
+		}
+		return messageType, frame.Data, nil
+	}
+
+	<-c.closed
+	return 0, nil, errReplayConnClosed
+}
+
+type replayAddr struct{}
+
+func (replayAddr) Network() string { return "replay" }
+func (replayAddr) String() string  { return "replay" }
@@ -0,0 +1,152 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/ftl/tci/client/internal/registry"
+)
+
+// ResyncHook customizes the re-synchronization of the TCI server after a connection was established.
+// It receives the commands that restore the streams, sensors, and spots the application requested before, in the order
+// they will be sent, and returns the commands that should actually be sent. The hook may filter, reorder, or add commands.
+// The returned commands are checked against the protocol version and the device limits before they are sent.
+type ResyncHook func(commands []Message) []Message
+
+type resyncGroup int
+
+// the commands are replayed in the order of these groups: first the settings, then the streams, then the spots
+const (
+	resyncSetting resyncGroup = iota
+	resyncStream
+	resyncSpot
+)
+
+type resyncEntry struct {
+	group   resyncGroup
+	key     string
+	message Message
+}
+
+// resyncState remembers the commands that are necessary to restore the desired state of the TCI server on a new connection.
+type resyncState struct {
+	lock    sync.Mutex
+	entries []resyncEntry
+}
+
+func (s *resyncState) remember(group resyncGroup, key string, message Message) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i, entry := range s.entries {
+		if entry.key == key {
+			s.entries[i].message = message
+			return
+		}
+	}
+	s.entries = append(s.entries, resyncEntry{group: group, key: key, message: message})
+}
+
+func (s *resyncState) forget(key string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for i, entry := range s.entries {
+		if entry.key == key {
+			s.entries = append(s.entries[:i], s.entries[i+1:]...)
+			return
+		}
+	}
+}
+
+func (s *resyncState) forgetGroup(group resyncGroup) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	entries := s.entries[:0]
+	for _, entry := range s.entries {
+		if entry.group != group {
+			entries = append(entries, entry)
+		}
+	}
+	s.entries = entries
+}
+
+func (s *resyncState) commands() []Message {
+	s.lock.Lock()
+	entries := make([]resyncEntry, len(s.entries))
+	copy(entries, s.entries)
+	s.lock.Unlock()
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].group < entries[j].group
+	})
+	result := make([]Message, len(entries))
+	for i, entry := range entries {
+		result[i] = entry.message
+	}
+	return result
+}
+
+func trxKey(name string, trx int) string {
+	return fmt.Sprintf("%s:%d", name, trx)
+}
+
+// ResyncCommands returns the commands that are sent to the TCI server when a new connection is established, to restore
+// the streams, sensors, sample rates, and spots that were requested before. Only commands that succeeded are restored.
+func (c *Client) ResyncCommands() []Message {
+	return c.resync.commands()
+}
+
+// resynchronize sends the remembered commands to the TCI server. It is called after the server is ready.
+// The commands are checked against the protocol version and the limits of the new device like any other command.
+func (c *Client) resynchronize() {
+	commands := c.resync.commands()
+	if c.resyncHook != nil {
+		commands = c.resyncHook(commands)
+	}
+	for _, command := range commands {
+		if err := command.Err(); err != nil {
+			c.logger.Warn("cannot resynchronize", "host", c.address, "name", command.Name(), "error", err)
+			continue
+		}
+		_, err := c.command(context.Background(), command.Name(), resyncArgs(command)...)
+		if errors.Is(err, ErrNotConnected) {
+			return
+		}
+		if err != nil {
+			c.logger.Warn("cannot resynchronize", "host", c.address, "name", command.Name(), "error", err)
+		}
+	}
+}
+
+// resyncArgs returns the arguments of the given command with the types that are declared in the registry, so that the
+// limits of the device can be checked. Arguments that cannot be converted are kept as strings.
+func resyncArgs(command Message) []interface{} {
+	declared, _ := registry.Lookup(command.Name())
+	result := make([]interface{}, len(command.Args()))
+	for i, arg := range command.Args() {
+		result[i] = arg
+		if i >= len(declared.Args) {
+			continue
+		}
+		switch declared.Args[i].Type {
+		case "int":
+			if value, err := strconv.Atoi(arg); err == nil {
+				result[i] = value
+			}
+		case "VFO":
+			if value, err := strconv.Atoi(arg); err == nil {
+				result[i] = VFO(value)
+			}
+		case "bool":
+			if value, err := strconv.ParseBool(arg); err == nil {
+				result[i] = value
+			}
+		case "Mode":
+			result[i] = Mode(arg)
+		}
+	}
+	return result
+}
@@ -0,0 +1,163 @@
+package client_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ftl/tci/client"
+	"github.com/ftl/tci/client/clienttest"
+)
+
+func TestResyncCommands(t *testing.T) {
+	_, c := openTestClient(t)
+
+	require.NoError(t, c.StartAudio(0))
+	require.NoError(t, c.StartIQ(1))
+	require.NoError(t, c.SetAudioSampleRate(client.AudioSampleRate24k))
+	require.NoError(t, c.AddSpot("DL1ABC", client.ModeCW, 7010000, client.NewARGB(255, 0, 0, 0), "test"))
+	require.NoError(t, c.AddSpot("DL2ABC", client.ModeCW, 7011000, client.NewARGB(255, 0, 0, 0), "test"))
+	require.NoError(t, c.SetRXSensorsEnable(true, 500))
+	require.NoError(t, c.StopIQ(1))
+	require.NoError(t, c.DeleteSpot("DL2ABC"))
+
+	assert.Equal(t, []string{
+		"audio_samplerate:24000;",
+		"rx_sensors_enable:true,500;",
+		"audio_start:0;",
+		"spot:DL1ABC,cw,7010000,4278190080,test;",
+	}, messageStrings(c.ResyncCommands()))
+
+	require.NoError(t, c.ClearSpots())
+	require.NoError(t, c.SetRXSensorsEnable(false, 0))
+	assert.Equal(t, []string{
+		"audio_samplerate:24000;",
+		"audio_start:0;",
+	}, messageStrings(c.ResyncCommands()))
+}
+
+func TestResyncCommands_OnlyAfterSuccess(t *testing.T) {
+	handshake := clienttest.DefaultHandshake()
+	handshake[0] = client.NewCommandMessage("protocol", "ExpertSDR3", "1.5")
+	server := clienttest.NewServer(handshake...)
+	defer server.Close()
+	server.Silence("iq_samplerate")
+	c, err := client.OpenURL(server.URL(), client.WithDeviceLimits(true), client.WithStrictCommands(true))
+	require.NoError(t, err)
+	defer c.Disconnect()
+
+	assert.ErrorIs(t, c.SetAudioStreamSampleType(client.SampleTypeFloat32), client.ErrUnsupported)
+	assert.ErrorIs(t, c.StartIQ(5), client.ErrArgumentOutOfRange)
+	assert.ErrorIs(t, c.SetIQSampleRate(client.IQSampleRate96k), client.ErrNoEcho)
+	require.NoError(t, c.StartAudio(1))
+
+	assert.Equal(t, []string{"audio_start:1;"}, messageStrings(c.ResyncCommands()))
+}
+
+func TestResyncCommands_ForgetOnlyAfterSuccess(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	c, err := client.OpenURL(server.URL(), client.WithStrictCommands(true))
+	require.NoError(t, err)
+	defer c.Disconnect()
+	c.SetTimeout(50 * time.Millisecond)
+	require.NoError(t, c.StartIQ(0))
+	require.NoError(t, c.AddSpot("DL1ABC", client.ModeCW, 7010000, client.NewARGB(255, 0, 0, 0), "test"))
+	server.Silence("iq_stop")
+	server.Silence("spot_clear")
+
+	assert.ErrorIs(t, c.StopIQ(0), client.ErrNoEcho)
+	assert.ErrorIs(t, c.ClearSpots(), client.ErrNoEcho)
+
+	assert.Equal(t, []string{
+		"iq_start:0;",
+		"spot:DL1ABC,cw,7010000,4278190080,test;",
+	}, messageStrings(c.ResyncCommands()))
+}
+
+func TestKeepOpen_ResyncChecksTheDeviceLimits(t *testing.T) {
+	first := clienttest.NewServer()
+	defer first.Close()
+	handshake := clienttest.DefaultHandshake()
+	for i, msg := range handshake {
+		if msg.Name() == "trx_count" {
+			handshake[i] = client.NewCommandMessage("trx_count", 1)
+		}
+	}
+	second := clienttest.NewServer(handshake...)
+	defer second.Close()
+
+	var target atomic.Value
+	target.Store(first.Addr().String())
+	c := keepOpenRedirected(t, &target, client.WithDeviceLimits(true))
+	require.Eventually(t, c.Connected, time.Second, time.Millisecond)
+	require.NoError(t, c.StartIQ(1))
+	require.NoError(t, c.StartAudio(0))
+
+	target.Store(second.Addr().String())
+	first.DisconnectClients()
+
+	require.Eventually(t, func() bool { return len(second.Received()) > 0 }, time.Second, time.Millisecond)
+	assert.Equal(t, []string{"audio_start:0;"}, messageStrings(second.Received()))
+}
+
+func TestKeepOpen_ResyncsAfterReconnect(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	connected := make(chan bool, 2)
+
+	c := client.KeepOpen(server.Addr(), 10*time.Millisecond, false, client.ConnectionListenerFunc(func(value bool) {
+		connected <- value
+	}))
+	defer c.Disconnect()
+
+	require.True(t, <-connected)
+	require.NoError(t, c.StartAudio(0))
+	require.NoError(t, c.SetIQSampleRate(client.IQSampleRate96k))
+
+	server.DisconnectClients()
+	require.False(t, <-connected)
+	require.True(t, <-connected)
+
+	received := messageStrings(server.Received())
+	require.Len(t, received, 4)
+	assert.Equal(t, []string{"iq_samplerate:96000;", "audio_start:0;"}, received[2:])
+}
+
+func TestResyncHook(t *testing.T) {
+	server := clienttest.NewServer()
+	defer server.Close()
+	connected := make(chan bool, 2)
+
+	c, err := client.KeepOpenURL(server.URL(),
+		client.WithReconnectPolicy(client.FixedReconnectPolicy(10*time.Millisecond)),
+		client.WithResyncHook(func(commands []client.Message) []client.Message {
+			return append(commands, client.NewCommandMessage("volume", -10))
+		}),
+		client.WithListeners(client.ConnectionListenerFunc(func(value bool) {
+			connected <- value
+		})),
+	)
+	require.NoError(t, err)
+	defer c.Disconnect()
+
+	require.True(t, <-connected)
+	require.NoError(t, c.StartAudio(0))
+
+	server.DisconnectClients()
+	require.False(t, <-connected)
+	require.True(t, <-connected)
+
+	assert.Equal(t, []string{"volume:-10;", "audio_start:0;", "audio_start:0;", "volume:-10;"}, messageStrings(server.Received()))
+}
+
+func messageStrings(messages []client.Message) []string {
+	result := make([]string, len(messages))
+	for i, message := range messages {
+		result[i] = message.String()
+	}
+	return result
+}
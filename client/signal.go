@@ -0,0 +1,246 @@
+package client
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+)
+
+// DefaultSignalHistory is the time span of signal levels that is kept by a SignalMeter by default.
+const DefaultSignalHistory = time.Minute
+
+// S9 is the signal level of S9 in dBm on HF, according to the IARU Region 1 Technical Recommendation R.1.
+const S9 = -73.0
+
+// dBPerSUnit is the difference between two S-units in dB.
+const dBPerSUnit = 6.0
+
+// SignalLevel is the signal level of a TRX's RX channel, as reported by the TCI server.
+type SignalLevel struct {
+	TRX  int
+	VFO  VFO
+	DBm  float64
+	Time time.Time
+}
+
+// SUnits returns the S-meter reading of this signal level.
+func (l SignalLevel) SUnits() SUnits {
+	return ToSUnits(l.DBm)
+}
+
+// SUnits is a S-meter reading. One S-unit is 6 dB, S9 is -73 dBm.
+type SUnits struct {
+	// S is the S-unit from 0 to 9.
+	S int
+	// OverS9 is the level above S9 in dB, zero if the level is not above S9.
+	OverS9 float64
+}
+
+// ToSUnits converts the given signal level in dBm into S-units. The S-unit is rounded to the nearest S-unit.
+func ToSUnits(dBm float64) SUnits {
+	if dBm > S9 {
+		return SUnits{S: 9, OverS9: dBm - S9}
+	}
+	s := int(math.Round(9 + (dBm-S9)/dBPerSUnit))
+	if s < 0 {
+		s = 0
+	}
+	return SUnits{S: s}
+}
+
+// String returns the S-meter reading in the usual notation, e.g. S7 or S9+20.
+func (s SUnits) String() string {
+	over := int(math.Round(s.OverS9))
+	if over > 0 {
+		return fmt.Sprintf("S%d+%d", s.S, over)
+	}
+	return fmt.Sprintf("S%d", s.S)
+}
+
+// A SignalLevelListener is notified about every signal level that is recorded by a SignalMeter.
+type SignalLevelListener interface {
+	SignalLevelChanged(level SignalLevel)
+}
+
+// SignalLevelListenerFunc wraps a function with the SignalLevelListener interface.
+type SignalLevelListenerFunc func(level SignalLevel)
+
+// SignalLevelChanged implements the SignalLevelListener interface.
+func (f SignalLevelListenerFunc) SignalLevelChanged(level SignalLevel) {
+	f(level)
+}
+
+// SignalMeter keeps a history of the signal levels of each TRX's RX channels. It records the levels from the
+// RX_CHANNEL_SENSORS, RX_SENSORS and RX_SMETER messages and provides the peak and the average level over a
+// given window.
+//
+// RX_SENSORS only contains the level of the TRX and is recorded for VFO A. Once RX_CHANNEL_SENSORS was received
+// for a TRX, RX_SENSORS is ignored for this TRX to avoid duplicate levels. Likewise, RX_SMETER is ignored for a
+// channel once RX_CHANNEL_SENSORS was received for this channel. The server only sends the sensor messages after
+// they were enabled with SetRXSensorsEnable.
+type SignalMeter struct {
+	history     time.Duration
+	now         func() time.Time
+	listeners   subscriptions
+	unsubscribe Unsubscribe
+
+	lock           sync.Mutex
+	levels         map[signalChannel][]SignalLevel
+	channelSensors map[signalChannel]bool
+}
+
+type signalChannel struct {
+	trx int
+	vfo VFO
+}
+
+// NewSignalMeter returns a new SignalMeter that records the signal levels reported to the given client. The levels
+// are kept for the given history, or for DefaultSignalHistory if the history is not greater than zero.
+func NewSignalMeter(client *Client, history time.Duration) *SignalMeter {
+	result := newSignalMeter(history)
+	result.unsubscribe = client.Subscribe(result)
+	return result
+}
+
+func newSignalMeter(history time.Duration) *SignalMeter {
+	if history <= 0 {
+		history = DefaultSignalHistory
+	}
+	return &SignalMeter{
+		history:        history,
+		now:            time.Now,
+		levels:         make(map[signalChannel][]SignalLevel),
+		channelSensors: make(map[signalChannel]bool),
+	}
+}
+
+// Close stops the recording of signal levels.
+func (m *SignalMeter) Close() {
+	if m.unsubscribe != nil {
+		m.unsubscribe()
+	}
+}
+
+// OnSignalLevel subscribes the given function as SignalLevelListener.
+func (m *SignalMeter) OnSignalLevel(f func(level SignalLevel)) Unsubscribe {
+	return m.listeners.add(subscription{listener: SignalLevelListenerFunc(f)})
+}
+
+// Level returns the latest signal level of the given TRX's RX channel.
+func (m *SignalMeter) Level(trx int, vfo VFO) (SignalLevel, bool) {
+	levels := m.Levels(trx, vfo, m.history)
+	if len(levels) == 0 {
+		return SignalLevel{}, false
+	}
+	return levels[len(levels)-1], true
+}
+
+// Levels returns the signal levels of the given TRX's RX channel within the given window, oldest first. The window
+// is limited to the history of the SignalMeter; if it is not greater than zero, the whole history is returned.
+func (m *SignalMeter) Levels(trx int, vfo VFO, window time.Duration) []SignalLevel {
+	if window <= 0 || window > m.history {
+		window = m.history
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	levels := m.levels[signalChannel{trx, vfo}]
+	start := firstLevelAfter(levels, m.now().Add(-window))
+	result := make([]SignalLevel, len(levels)-start)
+	copy(result, levels[start:])
+	return result
+}
+
+// Peak returns the highest signal level of the given TRX's RX channel within the given window.
+func (m *SignalMeter) Peak(trx int, vfo VFO, window time.Duration) (SignalLevel, bool) {
+	levels := m.Levels(trx, vfo, window)
+	if len(levels) == 0 {
+		return SignalLevel{}, false
+	}
+	result := levels[0]
+	for _, level := range levels[1:] {
+		if level.DBm > result.DBm {
+			result = level
+		}
+	}
+	return result, true
+}
+
+// Average returns the average signal level of the given TRX's RX channel within the given window in dBm. The
+// average is calculated from the signal power, not from the dBm values.
+func (m *SignalMeter) Average(trx int, vfo VFO, window time.Duration) (float64, bool) {
+	levels := m.Levels(trx, vfo, window)
+	if len(levels) == 0 {
+		return 0, false
+	}
+	var sum float64
+	for _, level := range levels {
+		sum += math.Pow(10, level.DBm/10)
+	}
+	return 10 * math.Log10(sum/float64(len(levels))), true
+}
+
+// SetRXSensors handles the RX_SENSORS message.
+func (m *SignalMeter) SetRXSensors(trx int, dBm float64) {
+	m.lock.Lock()
+	ignore := false
+	for channel := range m.channelSensors {
+		ignore = ignore || channel.trx == trx
+	}
+	m.lock.Unlock()
+	if ignore {
+		return
+	}
+	m.record(trx, VFOA, dBm)
+}
+
+// SetRXChannelSensors handles the RX_CHANNEL_SENSORS message.
+func (m *SignalMeter) SetRXChannelSensors(trx int, vfo VFO, dBm float64) {
+	m.lock.Lock()
+	m.channelSensors[signalChannel{trx, vfo}] = true
+	m.lock.Unlock()
+	m.record(trx, vfo, dBm)
+}
+
+// SetRXSMeter handles the RX_SMETER message.
+func (m *SignalMeter) SetRXSMeter(trx int, vfo VFO, level int) {
+	m.lock.Lock()
+	ignore := m.channelSensors[signalChannel{trx, vfo}]
+	m.lock.Unlock()
+	if ignore {
+		return
+	}
+	m.record(trx, vfo, float64(level))
+}
+
+func (m *SignalMeter) record(trx int, vfo VFO, dBm float64) {
+	level := SignalLevel{TRX: trx, VFO: vfo, DBm: dBm, Time: m.now()}
+
+	m.lock.Lock()
+	channel := signalChannel{trx, vfo}
+	levels := m.levels[channel]
+	start := firstLevelAfter(levels, level.Time.Add(-m.history))
+	if start > len(levels)/2 {
+		levels = append(levels[:0], levels[start:]...)
+	} else {
+		levels = levels[start:]
+	}
+	m.levels[channel] = append(levels, level)
+	m.lock.Unlock()
+
+	for _, l := range m.listeners.current() {
+		if listener, ok := l.(SignalLevelListener); ok {
+			listener.SignalLevelChanged(level)
+		}
+	}
+}
+
+// firstLevelAfter returns the index of the first of the given levels that was recorded after the given time.
+func firstLevelAfter(levels []SignalLevel, t time.Time) int {
+	for i, level := range levels {
+		if level.Time.After(t) {
+			return i
+		}
+	}
+	return len(levels)
+}
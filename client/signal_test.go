@@ -0,0 +1,185 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeClock is advanced manually by the tests.
+type fakeClock struct {
+	t time.Time
+}
+
+func (c *fakeClock) now() time.Time {
+	return c.t
+}
+
+func (c *fakeClock) advance(d time.Duration) {
+	c.t = c.t.Add(d)
+}
+
+func newTestSignalMeter(history time.Duration) (*SignalMeter, *notifier, *fakeClock) {
+	clock := &fakeClock{t: time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)}
+	n := newNotifier(nil, make(chan struct{}))
+	m := newSignalMeter(history)
+	m.now = clock.now
+	m.unsubscribe = n.Subscribe(m)
+	return m, n, clock
+}
+
+func TestToSUnits(t *testing.T) {
+	tt := []struct {
+		dBm      float64
+		expected SUnits
+		text     string
+	}{
+		{-73, SUnits{S: 9}, "S9"},
+		{-79, SUnits{S: 8}, "S8"},
+		{-121, SUnits{S: 1}, "S1"},
+		{-100, SUnits{S: 5}, "S5"},
+		{-140, SUnits{S: 0}, "S0"},
+		{-53, SUnits{S: 9, OverS9: 20}, "S9+20"},
+		{-72.8, SUnits{S: 9, OverS9: 0.2}, "S9"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.text, func(t *testing.T) {
+			actual := ToSUnits(tc.dBm)
+			assert.Equal(t, tc.expected.S, actual.S)
+			assert.InDelta(t, tc.expected.OverS9, actual.OverS9, 0.001)
+			assert.Equal(t, tc.text, actual.String())
+		})
+	}
+}
+
+func TestSignalMeter_RecordsAllSensorMessages(t *testing.T) {
+	m, n, _ := newTestSignalMeter(0)
+	defer m.Close()
+
+	n.handleIncomingMessage(NewCommandMessage("rx_sensors", 0, -90.5), n.listeners())
+	n.handleIncomingMessage(NewCommandMessage("rx_smeter", 1, 1, -80), n.listeners())
+	n.handleIncomingMessage(NewCommandMessage("rx_channel_sensors", 1, 0, -100.25), n.listeners())
+
+	level, ok := m.Level(0, VFOA)
+	require.True(t, ok)
+	assert.Equal(t, -90.5, level.DBm)
+	level, ok = m.Level(1, VFOB)
+	require.True(t, ok)
+	assert.Equal(t, -80.0, level.DBm)
+	level, ok = m.Level(1, VFOA)
+	require.True(t, ok)
+	assert.Equal(t, -100.25, level.DBm)
+	_, ok = m.Level(0, VFOB)
+	assert.False(t, ok)
+}
+
+func TestSignalMeter_IgnoresRXSensorsWithChannelSensors(t *testing.T) {
+	m, n, _ := newTestSignalMeter(0)
+	defer m.Close()
+
+	n.handleIncomingMessage(NewCommandMessage("rx_sensors", 0, -90), n.listeners())
+	n.handleIncomingMessage(NewCommandMessage("rx_channel_sensors", 0, 0, -95), n.listeners())
+	n.handleIncomingMessage(NewCommandMessage("rx_sensors", 0, -91), n.listeners())
+	n.handleIncomingMessage(NewCommandMessage("rx_sensors", 1, -92), n.listeners())
+
+	levels := m.Levels(0, VFOA, 0)
+	require.Len(t, levels, 2)
+	assert.Equal(t, -90.0, levels[0].DBm)
+	assert.Equal(t, -95.0, levels[1].DBm)
+	_, ok := m.Level(1, VFOA)
+	assert.True(t, ok)
+}
+
+func TestSignalMeter_IgnoresRXSMeterWithChannelSensors(t *testing.T) {
+	m, n, _ := newTestSignalMeter(0)
+	defer m.Close()
+
+	n.handleIncomingMessage(NewCommandMessage("rx_smeter", 0, 0, -90), n.listeners())
+	n.handleIncomingMessage(NewCommandMessage("rx_channel_sensors", 0, 0, -95), n.listeners())
+	n.handleIncomingMessage(NewCommandMessage("rx_smeter", 0, 0, -95), n.listeners())
+	n.handleIncomingMessage(NewCommandMessage("rx_smeter", 0, 1, -80), n.listeners())
+
+	levels := m.Levels(0, VFOA, 0)
+	require.Len(t, levels, 2)
+	assert.Equal(t, -90.0, levels[0].DBm)
+	assert.Equal(t, -95.0, levels[1].DBm)
+	level, ok := m.Level(0, VFOB)
+	require.True(t, ok)
+	assert.Equal(t, -80.0, level.DBm)
+}
+
+func TestSignalMeter_PeakAndAverage(t *testing.T) {
+	m, _, clock := newTestSignalMeter(time.Minute)
+	m.SetRXChannelSensors(0, VFOA, -60)
+	clock.advance(10 * time.Second)
+	m.SetRXChannelSensors(0, VFOA, -80)
+	clock.advance(10 * time.Second)
+	m.SetRXChannelSensors(0, VFOA, -70)
+
+	peak, ok := m.Peak(0, VFOA, time.Minute)
+	require.True(t, ok)
+	assert.Equal(t, -60.0, peak.DBm)
+	peak, ok = m.Peak(0, VFOA, 15*time.Second)
+	require.True(t, ok)
+	assert.Equal(t, -70.0, peak.DBm)
+
+	average, ok := m.Average(0, VFOA, 15*time.Second)
+	require.True(t, ok)
+	assert.InDelta(t, -72.6, average, 0.01)
+	average, ok = m.Average(0, VFOA, 0)
+	require.True(t, ok)
+	assert.InDelta(t, -64.32, average, 0.01)
+
+	_, ok = m.Peak(0, VFOB, time.Minute)
+	assert.False(t, ok)
+	_, ok = m.Average(0, VFOB, time.Minute)
+	assert.False(t, ok)
+}
+
+func TestSignalMeter_DropsLevelsOutsideHistory(t *testing.T) {
+	m, _, clock := newTestSignalMeter(time.Second)
+	for i := 0; i < 100; i++ {
+		m.SetRXChannelSensors(0, VFOA, float64(-100+i))
+		clock.advance(100 * time.Millisecond)
+	}
+
+	levels := m.Levels(0, VFOA, time.Hour)
+	require.Len(t, levels, 9)
+	assert.Equal(t, -9.0, levels[0].DBm)
+	assert.LessOrEqual(t, len(m.levels[signalChannel{0, VFOA}]), 10)
+
+	clock.advance(time.Second)
+	_, ok := m.Level(0, VFOA)
+	assert.False(t, ok)
+}
+
+func TestSignalMeter_NotifiesListeners(t *testing.T) {
+	m, n, clock := newTestSignalMeter(0)
+	var received []SignalLevel
+	unsubscribe := m.OnSignalLevel(func(level SignalLevel) {
+		received = append(received, level)
+	})
+
+	n.handleIncomingMessage(NewCommandMessage("rx_channel_sensors", 1, 1, -73), n.listeners())
+	unsubscribe()
+	n.handleIncomingMessage(NewCommandMessage("rx_channel_sensors", 1, 1, -80), n.listeners())
+
+	require.Len(t, received, 1)
+	assert.Equal(t, SignalLevel{TRX: 1, VFO: VFOB, DBm: -73, Time: clock.t}, received[0])
+	assert.Equal(t, "S9", received[0].SUnits().String())
+
+	// the unsubscribed listener is not notified anymore, but the meter still records the levels
+	level, ok := m.Level(1, VFOB)
+	require.True(t, ok)
+	assert.Equal(t, -80.0, level.DBm)
+
+	// the closed meter does not record any levels anymore
+	m.Close()
+	n.handleIncomingMessage(NewCommandMessage("rx_channel_sensors", 1, 1, -90), n.listeners())
+	level, ok = m.Level(1, VFOB)
+	require.True(t, ok)
+	assert.Equal(t, -80.0, level.DBm)
+	assert.Len(t, m.Levels(1, VFOB, 0), 2)
+}
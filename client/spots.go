@@ -0,0 +1,277 @@
+package client
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Spot is a spot on the panorama of the TCI server.
+type Spot struct {
+	Callsign  string
+	Mode      Mode
+	Frequency int
+	Color     ARGB
+	Text      string
+	// Expires is the point in time when the spot is deleted automatically, zero if the spot does not expire.
+	Expires time.Time
+}
+
+// SpotClick is reported when the operator clicks on a spot on the panorama of the TCI server.
+type SpotClick struct {
+	TRX       int
+	VFO       VFO
+	Callsign  string
+	Frequency int
+	// Spot is the spot that was clicked, if it was placed through the SpotManager.
+	Spot Spot
+	// Managed indicates that the clicked spot was placed through the SpotManager.
+	Managed bool
+}
+
+// A SpotClickListener is notified when the operator clicks on a spot on the panorama of the TCI server.
+type SpotClickListener interface {
+	SpotClicked(click SpotClick)
+}
+
+// SpotClickListenerFunc wraps a function with the SpotClickListener interface.
+type SpotClickListenerFunc func(click SpotClick)
+
+// SpotClicked implements the SpotClickListener interface.
+func (f SpotClickListenerFunc) SpotClicked(click SpotClick) {
+	f(click)
+}
+
+// SpotManager keeps track of the spots that were placed on the panorama of the TCI server. The spots can be updated
+// and expire after a configurable time to live. The SpotManager also reports clicks on spots as SpotClick.
+//
+// Since TCI 1.6, clicks are reported by the server with the RX_CLICKED_ON_SPOT message, which contains the TRX and
+// VFO. The older CLICKED_ON_SPOT message is then ignored to avoid duplicate clicks. Before TCI 1.6, clicks are
+// reported for TRX 0 and VFO A.
+type SpotManager struct {
+	client      *Client
+	ttl         time.Duration
+	listeners   subscriptions
+	unsubscribe []Unsubscribe
+
+	lock  sync.Mutex
+	spots map[string]*managedSpot
+}
+
+type managedSpot struct {
+	Spot
+	timer *time.Timer
+}
+
+// NewSpotManager returns a new SpotManager that places its spots through the given client. If the ttl is greater
+// than zero, the spots are deleted automatically after this time to live. Adding a spot again restarts its time to live.
+func NewSpotManager(client *Client, ttl time.Duration) *SpotManager {
+	result := &SpotManager{
+		client: client,
+		ttl:    ttl,
+		spots:  make(map[string]*managedSpot),
+	}
+	result.unsubscribe = []Unsubscribe{
+		client.OnClickedOnSpot(result.clickedOnSpot),
+		client.OnRXClickedOnSpot(result.rxClickedOnSpot),
+	}
+	return result
+}
+
+// Close stops the expiration of the spots and the reporting of clicks. The spots remain on the panorama.
+func (m *SpotManager) Close() {
+	for _, unsubscribe := range m.unsubscribe {
+		unsubscribe()
+	}
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	for _, spot := range m.spots {
+		spot.stopTimer()
+	}
+	m.spots = make(map[string]*managedSpot)
+}
+
+// OnSpotClick subscribes the given function as SpotClickListener.
+func (m *SpotManager) OnSpotClick(f func(click SpotClick)) Unsubscribe {
+	return m.listeners.add(subscription{listener: SpotClickListenerFunc(f)})
+}
+
+// Spots returns all spots that are currently placed through this SpotManager, ordered by callsign.
+func (m *SpotManager) Spots() []Spot {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	result := make([]Spot, 0, len(m.spots))
+	for _, spot := range m.spots {
+		result = append(result, spot.Spot)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Callsign < result[j].Callsign
+	})
+	return result
+}
+
+// Spot returns the spot with the given callsign, if it is currently placed through this SpotManager.
+func (m *SpotManager) Spot(callsign string) (Spot, bool) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	spot, ok := m.spots[callsign]
+	if !ok {
+		return Spot{}, false
+	}
+	return spot.Spot, true
+}
+
+// Add places the given spot on the panorama. A spot with the same callsign is replaced. The Expires field is ignored.
+func (m *SpotManager) Add(spot Spot) error {
+	return m.AddContext(context.Background(), spot)
+}
+
+// AddContext is like Add, but uses the given context.
+func (m *SpotManager) AddContext(ctx context.Context, spot Spot) error {
+	err := m.client.AddSpotContext(ctx, spot.Callsign, spot.Mode, spot.Frequency, spot.Color, spot.Text)
+	if err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if previous, ok := m.spots[spot.Callsign]; ok {
+		previous.stopTimer()
+	}
+	managed := &managedSpot{Spot: spot}
+	managed.Expires = time.Time{}
+	if m.ttl > 0 {
+		managed.Expires = time.Now().Add(m.ttl)
+		managed.timer = time.AfterFunc(m.ttl, func() {
+			m.expire(managed)
+		})
+	}
+	m.spots[spot.Callsign] = managed
+	return nil
+}
+
+// UpdateColor changes the color of the spot with the given callsign. It returns ErrUnknownSpot if the spot was not
+// placed through this SpotManager. The time to live of the spot is not changed.
+func (m *SpotManager) UpdateColor(callsign string, color ARGB) error {
+	return m.UpdateColorContext(context.Background(), callsign, color)
+}
+
+// UpdateColorContext is like UpdateColor, but uses the given context.
+func (m *SpotManager) UpdateColorContext(ctx context.Context, callsign string, color ARGB) error {
+	return m.update(ctx, callsign, func(spot *Spot) {
+		spot.Color = color
+	})
+}
+
+// UpdateText changes the text of the spot with the given callsign. It returns ErrUnknownSpot if the spot was not
+// placed through this SpotManager. The time to live of the spot is not changed.
+func (m *SpotManager) UpdateText(callsign string, text string) error {
+	return m.UpdateTextContext(context.Background(), callsign, text)
+}
+
+// UpdateTextContext is like UpdateText, but uses the given context.
+func (m *SpotManager) UpdateTextContext(ctx context.Context, callsign string, text string) error {
+	return m.update(ctx, callsign, func(spot *Spot) {
+		spot.Text = text
+	})
+}
+
+func (m *SpotManager) update(ctx context.Context, callsign string, change func(*Spot)) error {
+	m.lock.Lock()
+	managed, ok := m.spots[callsign]
+	if !ok {
+		m.lock.Unlock()
+		return ErrUnknownSpot
+	}
+	updated := managed.Spot
+	m.lock.Unlock()
+
+	change(&updated)
+	err := m.client.AddSpotContext(ctx, updated.Callsign, updated.Mode, updated.Frequency, updated.Color, updated.Text)
+	if err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if m.spots[callsign] == managed {
+		managed.Spot = updated
+	}
+	return nil
+}
+
+// Delete removes the spot with the given callsign from the panorama.
+func (m *SpotManager) Delete(callsign string) error {
+	return m.DeleteContext(context.Background(), callsign)
+}
+
+// DeleteContext is like Delete, but uses the given context.
+func (m *SpotManager) DeleteContext(ctx context.Context, callsign string) error {
+	m.lock.Lock()
+	if managed, ok := m.spots[callsign]; ok {
+		managed.stopTimer()
+		delete(m.spots, callsign)
+	}
+	m.lock.Unlock()
+
+	return m.client.DeleteSpotContext(ctx, callsign)
+}
+
+// Clear removes all spots from the panorama, including the spots that were not placed through this SpotManager.
+func (m *SpotManager) Clear() error {
+	return m.ClearContext(context.Background())
+}
+
+// ClearContext is like Clear, but uses the given context.
+func (m *SpotManager) ClearContext(ctx context.Context) error {
+	m.lock.Lock()
+	for _, managed := range m.spots {
+		managed.stopTimer()
+	}
+	m.spots = make(map[string]*managedSpot)
+	m.lock.Unlock()
+
+	return m.client.ClearSpotsContext(ctx)
+}
+
+func (m *SpotManager) expire(managed *managedSpot) {
+	m.lock.Lock()
+	if m.spots[managed.Callsign] != managed {
+		m.lock.Unlock()
+		return
+	}
+	delete(m.spots, managed.Callsign)
+	m.lock.Unlock()
+
+	err := m.client.DeleteSpot(managed.Callsign)
+	if err != nil {
+		m.client.logger.Warn("cannot delete expired spot", "callsign", managed.Callsign, "error", err)
+	}
+}
+
+func (s *managedSpot) stopTimer() {
+	if s.timer != nil {
+		s.timer.Stop()
+	}
+}
+
+func (m *SpotManager) clickedOnSpot(callsign string, frequency int) {
+	if m.client.Supports("rx_clicked_on_spot") {
+		return
+	}
+	m.clicked(SpotClick{TRX: 0, VFO: VFOA, Callsign: callsign, Frequency: frequency})
+}
+
+func (m *SpotManager) rxClickedOnSpot(trx int, vfo VFO, callsign string, frequency int) {
+	m.clicked(SpotClick{TRX: trx, VFO: vfo, Callsign: callsign, Frequency: frequency})
+}
+
+func (m *SpotManager) clicked(click SpotClick) {
+	click.Spot, click.Managed = m.Spot(click.Callsign)
+	for _, l := range m.listeners.current() {
+		if listener, ok := l.(SpotClickListener); ok {
+			listener.SpotClicked(click)
+		}
+	}
+}
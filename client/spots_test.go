@@ -0,0 +1,111 @@
+package client_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ftl/tci/client"
+	"github.com/ftl/tci/client/clienttest"
+)
+
+var (
+	red   = client.NewARGB(255, 255, 0, 0)
+	green = client.NewARGB(255, 0, 255, 0)
+)
+
+func TestSpotManager_AddAndUpdate(t *testing.T) {
+	server, c := openTestClient(t)
+	spots := client.NewSpotManager(c, 0)
+	defer spots.Close()
+
+	require.NoError(t, spots.Add(client.Spot{Callsign: "DL1ABC", Mode: client.ModeCW, Frequency: 7012000, Color: red, Text: "first"}))
+	require.NoError(t, spots.Add(client.Spot{Callsign: "DL0XYZ", Mode: client.ModeUSB, Frequency: 14200000, Color: red}))
+	require.NoError(t, spots.UpdateColor("DL1ABC", green))
+	require.NoError(t, spots.UpdateText("DL1ABC", "second"))
+
+	assert.Equal(t, []client.Spot{
+		{Callsign: "DL0XYZ", Mode: client.ModeUSB, Frequency: 14200000, Color: red},
+		{Callsign: "DL1ABC", Mode: client.ModeCW, Frequency: 7012000, Color: green, Text: "second"},
+	}, spots.Spots())
+	received := server.Received()
+	require.Len(t, received, 4)
+	assert.Equal(t, []string{"DL1ABC", "cw", "7012000", green.String(), "first"}, received[2].Args())
+	assert.Equal(t, []string{"DL1ABC", "cw", "7012000", green.String(), "second"}, received[3].Args())
+
+	assert.ErrorIs(t, spots.UpdateColor("DL2UNK", green), client.ErrUnknownSpot)
+}
+
+func TestSpotManager_DeleteAndClear(t *testing.T) {
+	server, c := openTestClient(t)
+	spots := client.NewSpotManager(c, 0)
+	defer spots.Close()
+	require.NoError(t, spots.Add(client.Spot{Callsign: "DL1ABC", Mode: client.ModeCW, Frequency: 7012000}))
+	require.NoError(t, spots.Add(client.Spot{Callsign: "DL0XYZ", Mode: client.ModeCW, Frequency: 7015000}))
+
+	require.NoError(t, spots.Delete("DL1ABC"))
+	_, ok := spots.Spot("DL1ABC")
+	assert.False(t, ok)
+	assert.Len(t, spots.Spots(), 1)
+
+	require.NoError(t, spots.Clear())
+	assert.Empty(t, spots.Spots())
+	received := server.Received()
+	assert.Equal(t, "spot_delete", received[2].Name())
+	assert.Equal(t, "spot_clear", received[3].Name())
+}
+
+func TestSpotManager_Expiry(t *testing.T) {
+	server, c := openTestClient(t)
+	spots := client.NewSpotManager(c, 20*time.Millisecond)
+	defer spots.Close()
+
+	before := time.Now()
+	require.NoError(t, spots.Add(client.Spot{Callsign: "DL1ABC", Mode: client.ModeCW, Frequency: 7012000}))
+	spot, ok := spots.Spot("DL1ABC")
+	require.True(t, ok)
+	assert.WithinDuration(t, before.Add(20*time.Millisecond), spot.Expires, 10*time.Millisecond)
+
+	assert.Eventually(t, func() bool { return len(spots.Spots()) == 0 }, time.Second, time.Millisecond)
+	assert.Eventually(t, func() bool {
+		received := server.Received()
+		return len(received) == 2 && received[1].Name() == "spot_delete"
+	}, time.Second, time.Millisecond)
+}
+
+func TestSpotManager_Clicks(t *testing.T) {
+	server, c := openTestClient(t)
+	spots := client.NewSpotManager(c, 0)
+	defer spots.Close()
+	clicks := make(chan client.SpotClick, 2)
+	spots.OnSpotClick(func(click client.SpotClick) { clicks <- click })
+	spot := client.Spot{Callsign: "DL1ABC", Mode: client.ModeCW, Frequency: 7012000, Color: red}
+	require.NoError(t, spots.Add(spot))
+
+	require.NoError(t, server.Send(client.NewCommandMessage("clicked_on_spot", "DL1ABC", 7012000)))
+	require.NoError(t, server.Send(client.NewCommandMessage("rx_clicked_on_spot", 1, 1, "DL1ABC", 7012000)))
+	require.NoError(t, server.Send(client.NewCommandMessage("rx_clicked_on_spot", 0, 0, "DL2UNK", 7020000)))
+
+	assert.Equal(t, client.SpotClick{TRX: 1, VFO: client.VFOB, Callsign: "DL1ABC", Frequency: 7012000, Spot: spot, Managed: true}, <-clicks)
+	assert.Equal(t, client.SpotClick{TRX: 0, VFO: client.VFOA, Callsign: "DL2UNK", Frequency: 7020000}, <-clicks)
+}
+
+func TestSpotManager_ClicksBeforeTCI1_6(t *testing.T) {
+	handshake := clienttest.DefaultHandshake()
+	handshake[0] = client.NewCommandMessage("protocol", "ExpertSDR2", "1.5")
+	server := clienttest.NewServer(handshake...)
+	defer server.Close()
+	c, err := client.OpenURL(server.URL())
+	require.NoError(t, err)
+	defer c.Disconnect()
+	spots := client.NewSpotManager(c, 0)
+	defer spots.Close()
+	clicks := make(chan client.SpotClick, 1)
+	spots.OnSpotClick(func(click client.SpotClick) { clicks <- click })
+
+	require.NoError(t, server.Send(client.NewCommandMessage("clicked_on_spot", "DL1ABC", 7012000)))
+
+	assert.Equal(t, client.SpotClick{TRX: 0, VFO: client.VFOA, Callsign: "DL1ABC", Frequency: 7012000}, <-clicks)
+}
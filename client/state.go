@@ -0,0 +1,411 @@
+package client
+
+import (
+	"sync"
+)
+
+// State mirrors the current state of the radio as reported by the TCI server. Register it with Client.Notify to
+// keep it up to date. The state is cleared when the connection is lost, the TCI server reports its current state
+// again when the client reconnects. All methods of State are safe for concurrent use.
+type State struct {
+	lock      sync.RWMutex
+	snapshot  StateSnapshot
+	listeners map[int]func(StateChange)
+	nextID    int
+}
+
+// StateSnapshot is a copy of the radio state at a certain point in time.
+type StateSnapshot struct {
+	Volume       int
+	Mute         bool
+	SquelchLevel int
+	TRXs         []TRXState
+}
+
+// TRXState contains the state of one TRX.
+type TRXState struct {
+	DDS           int
+	Mode          Mode
+	FilterMin     int
+	FilterMax     int
+	RXEnable      bool
+	RITEnable     bool
+	RITOffset     int
+	XITEnable     bool
+	XITOffset     int
+	SplitEnable   bool
+	TX            bool
+	Tune          bool
+	Drive         int
+	TuneDrive     int
+	SquelchEnable bool
+	RXMute        bool
+	NBEnable      bool
+	NREnable      bool
+	ANCEnable     bool
+	ANFEnable     bool
+	APFEnable     bool
+	RXLevel       float64
+	TXSensors     TXSensors
+	VFOs          [2]VFOState
+}
+
+// VFOState contains the state of one VFO of a TRX.
+type VFOState struct {
+	IF        int
+	Frequency int
+	Enabled   bool
+	SMeter    int
+	Volume    int
+	Balance   int
+}
+
+// TXSensors contains the transmitter sensor readings of one TRX.
+type TXSensors struct {
+	MicdBm float64
+	TXRMS  float64
+	TXPeak float64
+	SWR    float64
+}
+
+// StateChange describes which part of the state was changed. TRX and VFO are -1 if the change is not related to a certain TRX or VFO.
+type StateChange struct {
+	Name string
+	TRX  int
+	VFO  VFO
+}
+
+// NewState returns a new empty State.
+func NewState() *State {
+	return &State{
+		listeners: make(map[int]func(StateChange)),
+	}
+}
+
+// Snapshot returns a copy of the current state.
+func (s *State) Snapshot() StateSnapshot {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	result := s.snapshot
+	result.TRXs = make([]TRXState, len(s.snapshot.TRXs))
+	copy(result.TRXs, s.snapshot.TRXs)
+	return result
+}
+
+// TRX returns the state of the given TRX. The result is false if nothing is known about this TRX yet.
+func (s *State) TRX(trx int) (TRXState, bool) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	if trx < 0 || trx >= len(s.snapshot.TRXs) {
+		return TRXState{}, false
+	}
+	return s.snapshot.TRXs[trx], true
+}
+
+// VFO returns the state of the given TRX's VFO. The result is false if nothing is known about this TRX yet.
+func (s *State) VFO(trx int, vfo VFO) (VFOState, bool) {
+	trxState, ok := s.TRX(trx)
+	if !ok || !validVFO(vfo) {
+		return VFOState{}, false
+	}
+	return trxState.VFOs[vfo], true
+}
+
+// DDS returns the center frequency of the given TRX's panorama.
+func (s *State) DDS(trx int) int {
+	trxState, _ := s.TRX(trx)
+	return trxState.DDS
+}
+
+// VFOFrequency returns the tuning frequency of the given TRX's vfo.
+func (s *State) VFOFrequency(trx int, vfo VFO) int {
+	vfoState, _ := s.VFO(trx, vfo)
+	return vfoState.Frequency
+}
+
+// Mode returns the mode of the given TRX.
+func (s *State) Mode(trx int) Mode {
+	trxState, _ := s.TRX(trx)
+	return trxState.Mode
+}
+
+// TX indicates if the given TRX is currently transmitting.
+func (s *State) TX(trx int) bool {
+	trxState, _ := s.TRX(trx)
+	return trxState.TX
+}
+
+// Volume returns the main volume in dB.
+func (s *State) Volume() int {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.snapshot.Volume
+}
+
+// Mute returns the main volume's mute state.
+func (s *State) Mute() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.snapshot.Mute
+}
+
+// Subscribe registers the given function to be called after every change of the state.
+// The returned function removes the subscription.
+func (s *State) Subscribe(f func(StateChange)) func() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	id := s.nextID
+	s.nextID++
+	s.listeners[id] = f
+	return func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		delete(s.listeners, id)
+	}
+}
+
+func (s *State) update(name string, f func(*StateSnapshot)) {
+	s.lock.Lock()
+	f(&s.snapshot)
+	listeners := s.copyListeners()
+	s.lock.Unlock()
+	s.notify(listeners, StateChange{Name: name, TRX: -1, VFO: -1})
+}
+
+func (s *State) updateTRX(name string, trx int, f func(*TRXState)) {
+	s.updateVFO(name, trx, -1, f)
+}
+
+func (s *State) updateVFO(name string, trx int, vfo VFO, f func(*TRXState)) {
+	if trx < 0 {
+		return
+	}
+	s.lock.Lock()
+	for len(s.snapshot.TRXs) <= trx {
+		s.snapshot.TRXs = append(s.snapshot.TRXs, TRXState{})
+	}
+	f(&s.snapshot.TRXs[trx])
+	listeners := s.copyListeners()
+	s.lock.Unlock()
+	s.notify(listeners, StateChange{Name: name, TRX: trx, VFO: vfo})
+}
+
+func (s *State) copyListeners() []func(StateChange) {
+	result := make([]func(StateChange), 0, len(s.listeners))
+	for _, l := range s.listeners {
+		result = append(result, l)
+	}
+	return result
+}
+
+func (s *State) notify(listeners []func(StateChange), change StateChange) {
+	for _, l := range listeners {
+		l(change)
+	}
+}
+
+// Connected implements the ConnectionListener interface. When the connection is lost, the state is cleared and the
+// listeners are notified with a StateChange named "reset".
+func (s *State) Connected(connected bool) {
+	if connected {
+		return
+	}
+	s.update("reset", func(snapshot *StateSnapshot) { *snapshot = StateSnapshot{} })
+}
+
+func validVFO(vfo VFO) bool {
+	return vfo == VFOA || vfo == VFOB
+}
+
+// SetDDS implements the DDSListener interface.
+func (s *State) SetDDS(trx int, frequency int) {
+	s.updateTRX("dds", trx, func(t *TRXState) { t.DDS = frequency })
+}
+
+// SetIF implements the IFListener interface.
+func (s *State) SetIF(trx int, vfo VFO, frequency int) {
+	if !validVFO(vfo) {
+		return
+	}
+	s.updateVFO("if", trx, vfo, func(t *TRXState) { t.VFOs[vfo].IF = frequency })
+}
+
+// SetVFOFrequency implements the VFOFrequencyListener interface.
+func (s *State) SetVFOFrequency(trx int, vfo VFO, frequency int) {
+	if !validVFO(vfo) {
+		return
+	}
+	s.updateVFO("vfo", trx, vfo, func(t *TRXState) { t.VFOs[vfo].Frequency = frequency })
+}
+
+// SetRXChannelEnable implements the RXChannelEnableListener interface.
+func (s *State) SetRXChannelEnable(trx int, vfo VFO, enabled bool) {
+	if !validVFO(vfo) {
+		return
+	}
+	s.updateVFO("rx_channel_enable", trx, vfo, func(t *TRXState) { t.VFOs[vfo].Enabled = enabled })
+}
+
+// SetRXSMeter implements the RXSMeterListener interface.
+func (s *State) SetRXSMeter(trx int, vfo VFO, level int) {
+	if !validVFO(vfo) {
+		return
+	}
+	s.updateVFO("rx_smeter", trx, vfo, func(t *TRXState) { t.VFOs[vfo].SMeter = level })
+}
+
+// SetRXVolume implements the RXVolumeListener interface.
+func (s *State) SetRXVolume(trx int, vfo VFO, dB int) {
+	if !validVFO(vfo) {
+		return
+	}
+	s.updateVFO("rx_volume", trx, vfo, func(t *TRXState) { t.VFOs[vfo].Volume = dB })
+}
+
+// SetRXBalance implements the RXBalanceListener interface.
+func (s *State) SetRXBalance(trx int, vfo VFO, dB int) {
+	if !validVFO(vfo) {
+		return
+	}
+	s.updateVFO("rx_balance", trx, vfo, func(t *TRXState) { t.VFOs[vfo].Balance = dB })
+}
+
+// SetMode implements the ModeListener interface.
+func (s *State) SetMode(trx int, mode Mode) {
+	s.updateTRX("modulation", trx, func(t *TRXState) { t.Mode = mode })
+}
+
+// SetRXFilterBand implements the RXFilterBandListener interface.
+func (s *State) SetRXFilterBand(trx int, min, max int) {
+	s.updateTRX("rx_filter_band", trx, func(t *TRXState) {
+		t.FilterMin = min
+		t.FilterMax = max
+	})
+}
+
+// SetRXEnable implements the RXEnableListener interface.
+func (s *State) SetRXEnable(trx int, enabled bool) {
+	s.updateTRX("rx_enable", trx, func(t *TRXState) { t.RXEnable = enabled })
+}
+
+// SetRITEnable implements the RITEnableListener interface.
+func (s *State) SetRITEnable(trx int, enabled bool) {
+	s.updateTRX("rit_enable", trx, func(t *TRXState) { t.RITEnable = enabled })
+}
+
+// SetRITOffset implements the RITOffsetListener interface.
+func (s *State) SetRITOffset(trx int, offset int) {
+	s.updateTRX("rit_offset", trx, func(t *TRXState) { t.RITOffset = offset })
+}
+
+// SetXITEnable implements the XITEnableListener interface.
+func (s *State) SetXITEnable(trx int, enabled bool) {
+	s.updateTRX("xit_enable", trx, func(t *TRXState) { t.XITEnable = enabled })
+}
+
+// SetXITOffset implements the XITOffsetListener interface.
+func (s *State) SetXITOffset(trx int, offset int) {
+	s.updateTRX("xit_offset", trx, func(t *TRXState) { t.XITOffset = offset })
+}
+
+// SetSplitEnable implements the SplitEnableListener interface.
+func (s *State) SetSplitEnable(trx int, enabled bool) {
+	s.updateTRX("split_enable", trx, func(t *TRXState) { t.SplitEnable = enabled })
+}
+
+// SetTX implements the TXListener interface.
+func (s *State) SetTX(trx int, enabled bool) {
+	s.updateTRX("trx", trx, func(t *TRXState) { t.TX = enabled })
+}
+
+// SetTune implements the TuneListener interface.
+func (s *State) SetTune(trx int, enabled bool) {
+	s.updateTRX("tune", trx, func(t *TRXState) { t.Tune = enabled })
+}
+
+// SetDrive implements the DriveListener interface.
+func (s *State) SetDrive(percent int) {
+	s.SetTRXDrive(0, percent)
+}
+
+// SetTRXDrive implements the TRXDriveListener interface.
+func (s *State) SetTRXDrive(trx int, percent int) {
+	s.updateTRX("drive", trx, func(t *TRXState) { t.Drive = percent })
+}
+
+// SetTuneDrive implements the TuneDriveListener interface.
+func (s *State) SetTuneDrive(percent int) {
+	s.SetTRXTuneDrive(0, percent)
+}
+
+// SetTRXTuneDrive implements the TRXTuneDriveListener interface.
+func (s *State) SetTRXTuneDrive(trx int, percent int) {
+	s.updateTRX("tune_drive", trx, func(t *TRXState) { t.TuneDrive = percent })
+}
+
+// SetSquelchEnable implements the SquelchEnableListener interface.
+func (s *State) SetSquelchEnable(trx int, enabled bool) {
+	s.updateTRX("sql_enable", trx, func(t *TRXState) { t.SquelchEnable = enabled })
+}
+
+// SetSquelchLevel implements the SquelchLevelListener interface.
+func (s *State) SetSquelchLevel(dB int) {
+	s.update("sql_level", func(snapshot *StateSnapshot) { snapshot.SquelchLevel = dB })
+}
+
+// SetVolume implements the VolumeListener interface.
+func (s *State) SetVolume(dB int) {
+	s.update("volume", func(snapshot *StateSnapshot) { snapshot.Volume = dB })
+}
+
+// SetMute implements the MuteListener interface.
+func (s *State) SetMute(muted bool) {
+	s.update("mute", func(snapshot *StateSnapshot) { snapshot.Mute = muted })
+}
+
+// SetRXMute implements the RXMuteListener interface.
+func (s *State) SetRXMute(trx int, muted bool) {
+	s.updateTRX("rx_mute", trx, func(t *TRXState) { t.RXMute = muted })
+}
+
+// SetRXNBEnable implements the RXNBEnableListener interface.
+func (s *State) SetRXNBEnable(trx int, enabled bool) {
+	s.updateTRX("rx_nb_enable", trx, func(t *TRXState) { t.NBEnable = enabled })
+}
+
+// SetRXNREnable implements the RXNREnableListener interface.
+func (s *State) SetRXNREnable(trx int, enabled bool) {
+	s.updateTRX("rx_nr_enable", trx, func(t *TRXState) { t.NREnable = enabled })
+}
+
+// SetRXANCEnable implements the RXANCEnableListener interface.
+func (s *State) SetRXANCEnable(trx int, enabled bool) {
+	s.updateTRX("rx_anc_enable", trx, func(t *TRXState) { t.ANCEnable = enabled })
+}
+
+// SetRXANFEnable implements the RXANFEnableListener interface.
+func (s *State) SetRXANFEnable(trx int, enabled bool) {
+	s.updateTRX("rx_anf_enable", trx, func(t *TRXState) { t.ANFEnable = enabled })
+}
+
+// SetRXAPFEnable implements the RXAPFEnableListener interface.
+func (s *State) SetRXAPFEnable(trx int, enabled bool) {
+	s.updateTRX("rx_apf_enable", trx, func(t *TRXState) { t.APFEnable = enabled })
+}
+
+// SetRXSensors implements the RXSensorsListener interface.
+func (s *State) SetRXSensors(trx int, dBm float64) {
+	s.updateTRX("rx_sensors", trx, func(t *TRXState) { t.RXLevel = dBm })
+}
+
+// SetTXSensors implements the TXSensorsListener interface.
+func (s *State) SetTXSensors(trx int, micdBm float64, txRMS float64, txPeak float64, swr float64) {
+	s.updateTRX("tx_sensors", trx, func(t *TRXState) {
+		t.TXSensors = TXSensors{
+			MicdBm: micdBm,
+			TXRMS:  txRMS,
+			TXPeak: txPeak,
+			SWR:    swr,
+		}
+	})
+}
@@ -0,0 +1,102 @@
+package client_test
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ftl/tci/client"
+	"github.com/ftl/tci/client/clienttest"
+)
+
+func TestState_MirrorsHandshake(t *testing.T) {
+	state := client.NewState()
+	_, _ = openTestClient(t, state)
+
+	snapshot := state.Snapshot()
+	require.Len(t, snapshot.TRXs, 2)
+	assert.Equal(t, -10, snapshot.Volume)
+	assert.Equal(t, 7000000, snapshot.TRXs[0].DDS)
+	assert.Equal(t, client.ModeCW, snapshot.TRXs[0].Mode)
+	assert.Equal(t, -250, snapshot.TRXs[0].FilterMin)
+	assert.Equal(t, 250, snapshot.TRXs[0].FilterMax)
+	assert.Equal(t, 50, snapshot.TRXs[1].Drive)
+	assert.Equal(t, 7012500, state.VFOFrequency(0, client.VFOB))
+	assert.Equal(t, 14010000, state.VFOFrequency(1, client.VFOA))
+}
+
+func TestState_NotifiesChanges(t *testing.T) {
+	state := client.NewState()
+	_, c := openTestClient(t, state)
+	changes := make(chan client.StateChange, 1)
+	unsubscribe := state.Subscribe(func(change client.StateChange) {
+		changes <- change
+	})
+
+	require.NoError(t, c.SetVFOFrequency(1, client.VFOB, 14020000))
+	select {
+	case change := <-changes:
+		assert.Equal(t, client.StateChange{Name: "vfo", TRX: 1, VFO: client.VFOB}, change)
+	case <-time.After(time.Second):
+		t.Fatal("no state change")
+	}
+	assert.Equal(t, 14020000, state.VFOFrequency(1, client.VFOB))
+
+	unsubscribe()
+	require.NoError(t, c.SetMute(true))
+	assert.Eventually(t, state.Mute, time.Second, time.Millisecond)
+	assert.Empty(t, changes)
+}
+
+func TestState_Unsubscribe(t *testing.T) {
+	state := client.NewState()
+	var first, second []client.StateChange
+	unsubscribeFirst := state.Subscribe(func(change client.StateChange) {
+		first = append(first, change)
+	})
+	unsubscribeSecond := state.Subscribe(func(change client.StateChange) {
+		second = append(second, change)
+	})
+	defer unsubscribeSecond()
+
+	state.SetVolume(-20)
+	unsubscribeFirst()
+	unsubscribeFirst()
+	state.SetMute(true)
+
+	assert.Equal(t, []client.StateChange{{Name: "volume", TRX: -1, VFO: -1}}, first)
+	assert.Equal(t, []client.StateChange{{Name: "volume", TRX: -1, VFO: -1}, {Name: "mute", TRX: -1, VFO: -1}}, second)
+}
+
+func TestState_ResetWhenReconnecting(t *testing.T) {
+	first := clienttest.NewServer()
+	defer first.Close()
+	second := clienttest.NewServer(handshakeWithout("SunSDR2DX", "vfo", "volume")...)
+	defer second.Close()
+	state := client.NewState()
+	resets := make(chan client.StateChange, 1)
+	state.Subscribe(func(change client.StateChange) {
+		if change.Name == "reset" {
+			resets <- change
+		}
+	})
+
+	var target atomic.Value
+	target.Store(first.Addr().String())
+	c := keepOpenRedirected(t, &target, client.WithListeners(state))
+	require.Eventually(t, func() bool { return c.DeviceInfo().DeviceName == "SunSDR2PRO" }, time.Second, time.Millisecond)
+	require.Equal(t, 7012500, state.VFOFrequency(0, client.VFOB))
+	require.Equal(t, -10, state.Volume())
+
+	target.Store(second.Addr().String())
+	first.DisconnectClients()
+
+	assert.Equal(t, client.StateChange{Name: "reset", TRX: -1, VFO: -1}, <-resets)
+	require.Eventually(t, func() bool { return c.DeviceInfo().DeviceName == "SunSDR2DX" }, time.Second, time.Millisecond)
+	assert.Equal(t, 7000000, state.DDS(0))
+	assert.Zero(t, state.VFOFrequency(0, client.VFOB))
+	assert.Zero(t, state.Volume())
+}
@@ -0,0 +1,254 @@
+package client
+
+import "sync"
+
+// Unsubscribe removes a subscription. It is safe to call it more than once.
+type Unsubscribe func()
+
+type subscription struct {
+	id       int
+	listener interface{}
+	// inline listeners are notified about text messages directly in the read loop, see notifier.subscribeInline.
+	inline bool
+	text   *textDispatcher
+	stream *streamDispatcher
+}
+
+type subscriptions struct {
+	lock              sync.RWMutex
+	entries           []subscription
+	listeners         []interface{}
+	inline            []interface{}
+	textDispatchers   []*textDispatcher
+	streamDispatchers []*streamDispatcher
+	nextID            int
+}
+
+// current returns the currently subscribed listeners. The returned slice is never modified.
+func (s *subscriptions) current() []interface{} {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.listeners
+}
+
+// currentInline returns the currently subscribed inline listeners. The returned slice is never modified.
+func (s *subscriptions) currentInline() []interface{} {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.inline
+}
+
+// currentTextDispatchers returns the dispatchers of all currently subscribed listeners for text messages that are not
+// inline. The returned slice is never modified.
+func (s *subscriptions) currentTextDispatchers() []*textDispatcher {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.textDispatchers
+}
+
+// currentStreamDispatchers returns the dispatchers of all currently subscribed streaming listeners. The returned slice is never modified.
+func (s *subscriptions) currentStreamDispatchers() []*streamDispatcher {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.streamDispatchers
+}
+
+func (s *subscriptions) add(entry subscription) Unsubscribe {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	id := s.nextID
+	s.nextID++
+	entry.id = id
+	s.entries = append(s.entries, entry)
+	s.updateListeners()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			s.remove(id)
+		})
+	}
+}
+
+func (s *subscriptions) remove(id int) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	entries := make([]subscription, 0, len(s.entries))
+	for _, e := range s.entries {
+		if e.id != id {
+			entries = append(entries, e)
+			continue
+		}
+		if e.text != nil {
+			e.text.stop()
+		}
+		if e.stream != nil {
+			e.stream.stop()
+		}
+	}
+	s.entries = entries
+	s.updateListeners()
+}
+
+func (s *subscriptions) updateListeners() {
+	listeners := make([]interface{}, len(s.entries))
+	inline := make([]interface{}, 0, len(s.entries))
+	textDispatchers := make([]*textDispatcher, 0, len(s.entries))
+	streamDispatchers := make([]*streamDispatcher, 0, len(s.entries))
+	for i, e := range s.entries {
+		listeners[i] = e.listener
+		if e.inline {
+			inline = append(inline, e.listener)
+		}
+		if e.text != nil {
+			textDispatchers = append(textDispatchers, e.text)
+		}
+		if e.stream != nil {
+			streamDispatchers = append(streamDispatchers, e.stream)
+		}
+	}
+	s.listeners = listeners
+	s.inline = inline
+	s.textDispatchers = textDispatchers
+	s.streamDispatchers = streamDispatchers
+}
+
+// MessageListenerFunc wraps a function with the MessageListener interface.
+type MessageListenerFunc func(msg Message)
+
+// Message implements the MessageListener interface.
+func (f MessageListenerFunc) Message(msg Message) {
+	f(msg)
+}
+
+// OnMessage subscribes the given function as MessageListener.
+func (n *notifier) OnMessage(f func(msg Message)) Unsubscribe {
+	return n.Subscribe(MessageListenerFunc(f))
+}
+
+// ModesListenerFunc wraps a function with the ModesListener interface.
+type ModesListenerFunc func(modes []Mode)
+
+// SetModes implements the ModesListener interface.
+func (f ModesListenerFunc) SetModes(modes []Mode) {
+	f(modes)
+}
+
+// OnModes subscribes the given function as ModesListener.
+func (n *notifier) OnModes(f func(modes []Mode)) Unsubscribe {
+	return n.Subscribe(ModesListenerFunc(f))
+}
+
+// DriveListenerFunc wraps a function with the DriveListener interface.
+type DriveListenerFunc func(percent int)
+
+// SetDrive implements the DriveListener interface.
+func (f DriveListenerFunc) SetDrive(percent int) {
+	f(percent)
+}
+
+// OnDrive subscribes the given function as DriveListener.
+func (n *notifier) OnDrive(f func(percent int)) Unsubscribe {
+	return n.Subscribe(DriveListenerFunc(f))
+}
+
+// TRXDriveListenerFunc wraps a function with the TRXDriveListener interface.
+type TRXDriveListenerFunc func(trx int, percent int)
+
+// SetTRXDrive implements the TRXDriveListener interface.
+func (f TRXDriveListenerFunc) SetTRXDrive(trx int, percent int) {
+	f(trx, percent)
+}
+
+// OnTRXDrive subscribes the given function as TRXDriveListener.
+func (n *notifier) OnTRXDrive(f func(trx int, percent int)) Unsubscribe {
+	return n.Subscribe(TRXDriveListenerFunc(f))
+}
+
+// TuneDriveListenerFunc wraps a function with the TuneDriveListener interface.
+type TuneDriveListenerFunc func(percent int)
+
+// SetTuneDrive implements the TuneDriveListener interface.
+func (f TuneDriveListenerFunc) SetTuneDrive(percent int) {
+	f(percent)
+}
+
+// OnTuneDrive subscribes the given function as TuneDriveListener.
+func (n *notifier) OnTuneDrive(f func(percent int)) Unsubscribe {
+	return n.Subscribe(TuneDriveListenerFunc(f))
+}
+
+// TRXTuneDriveListenerFunc wraps a function with the TRXTuneDriveListener interface.
+type TRXTuneDriveListenerFunc func(trx int, percent int)
+
+// SetTRXTuneDrive implements the TRXTuneDriveListener interface.
+func (f TRXTuneDriveListenerFunc) SetTRXTuneDrive(trx int, percent int) {
+	f(trx, percent)
+}
+
+// OnTRXTuneDrive subscribes the given function as TRXTuneDriveListener.
+func (n *notifier) OnTRXTuneDrive(f func(trx int, percent int)) Unsubscribe {
+	return n.Subscribe(TRXTuneDriveListenerFunc(f))
+}
+
+// BinaryMessageListenerFunc wraps a function with the BinaryMessageListener interface.
+type BinaryMessageListenerFunc func(msg BinaryMessage)
+
+// BinaryMessage implements the BinaryMessageListener interface.
+func (f BinaryMessageListenerFunc) BinaryMessage(msg BinaryMessage) {
+	f(msg)
+}
+
+// OnBinaryMessage subscribes the given function as BinaryMessageListener.
+func (n *notifier) OnBinaryMessage(f func(msg BinaryMessage)) Unsubscribe {
+	return n.Subscribe(BinaryMessageListenerFunc(f))
+}
+
+// IQDataListenerFunc wraps a function with the IQDataListener interface.
+type IQDataListenerFunc func(trx int, sampleRate IQSampleRate, data []float32)
+
+// IQData implements the IQDataListener interface.
+func (f IQDataListenerFunc) IQData(trx int, sampleRate IQSampleRate, data []float32) {
+	f(trx, sampleRate, data)
+}
+
+// OnIQData subscribes the given function as IQDataListener.
+func (n *notifier) OnIQData(f func(trx int, sampleRate IQSampleRate, data []float32)) Unsubscribe {
+	return n.Subscribe(IQDataListenerFunc(f))
+}
+
+// RXAudioListenerFunc wraps a function with the RXAudioListener interface.
+type RXAudioListenerFunc func(trx int, sampleRate AudioSampleRate, samples []float32)
+
+// RXAudio implements the RXAudioListener interface.
+func (f RXAudioListenerFunc) RXAudio(trx int, sampleRate AudioSampleRate, samples []float32) {
+	f(trx, sampleRate, samples)
+}
+
+// OnRXAudio subscribes the given function as RXAudioListener.
+func (n *notifier) OnRXAudio(f func(trx int, sampleRate AudioSampleRate, samples []float32)) Unsubscribe {
+	return n.Subscribe(RXAudioListenerFunc(f))
+}
+
+// TXChronoListenerFunc wraps a function with the TXChronoListener interface.
+type TXChronoListenerFunc func(trx int, sampleRate AudioSampleRate, requestedSampleCount uint32)
+
+// TXChrono implements the TXChronoListener interface.
+func (f TXChronoListenerFunc) TXChrono(trx int, sampleRate AudioSampleRate, requestedSampleCount uint32) {
+	f(trx, sampleRate, requestedSampleCount)
+}
+
+// OnTXChrono subscribes the given function as TXChronoListener.
+func (n *notifier) OnTXChrono(f func(trx int, sampleRate AudioSampleRate, requestedSampleCount uint32)) Unsubscribe {
+	return n.Subscribe(TXChronoListenerFunc(f))
+}
+
+// OnConnected subscribes the given function as ConnectionListener.
+func (n *notifier) OnConnected(f func(connected bool)) Unsubscribe {
+	return n.Subscribe(ConnectionListenerFunc(f))
+}
+
+// OnReconnectFailed subscribes the given function as ReconnectListener.
+func (n *notifier) OnReconnectFailed(f func(attempt ReconnectAttempt)) Unsubscribe {
+	return n.Subscribe(ReconnectListenerFunc(f))
+}
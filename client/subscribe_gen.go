@@ -0,0 +1,1030 @@
+// Code generated by go run ./internal/gen; DO NOT EDIT.
+
+package client
+
+// ProtocolListenerFunc wraps a function with the ProtocolListener interface.
+type ProtocolListenerFunc func(name string, version string)
+
+// SetProtocol implements the ProtocolListener interface.
+func (f ProtocolListenerFunc) SetProtocol(name string, version string) {
+	f(name, version)
+}
+
+// OnProtocol subscribes the given function as ProtocolListener.
+func (n *notifier) OnProtocol(f func(name string, version string)) Unsubscribe {
+	return n.Subscribe(ProtocolListenerFunc(f))
+}
+
+// VFOLimitsListenerFunc wraps a function with the VFOLimitsListener interface.
+type VFOLimitsListenerFunc func(min int, max int)
+
+// SetVFOLimits implements the VFOLimitsListener interface.
+func (f VFOLimitsListenerFunc) SetVFOLimits(min int, max int) {
+	f(min, max)
+}
+
+// OnVFOLimits subscribes the given function as VFOLimitsListener.
+func (n *notifier) OnVFOLimits(f func(min int, max int)) Unsubscribe {
+	return n.Subscribe(VFOLimitsListenerFunc(f))
+}
+
+// IFLimitsListenerFunc wraps a function with the IFLimitsListener interface.
+type IFLimitsListenerFunc func(min int, max int)
+
+// SetIFLimits implements the IFLimitsListener interface.
+func (f IFLimitsListenerFunc) SetIFLimits(min int, max int) {
+	f(min, max)
+}
+
+// OnIFLimits subscribes the given function as IFLimitsListener.
+func (n *notifier) OnIFLimits(f func(min int, max int)) Unsubscribe {
+	return n.Subscribe(IFLimitsListenerFunc(f))
+}
+
+// TRXCountListenerFunc wraps a function with the TRXCountListener interface.
+type TRXCountListenerFunc func(count int)
+
+// SetTRXCount implements the TRXCountListener interface.
+func (f TRXCountListenerFunc) SetTRXCount(count int) {
+	f(count)
+}
+
+// OnTRXCount subscribes the given function as TRXCountListener.
+func (n *notifier) OnTRXCount(f func(count int)) Unsubscribe {
+	return n.Subscribe(TRXCountListenerFunc(f))
+}
+
+// ChannelCountListenerFunc wraps a function with the ChannelCountListener interface.
+type ChannelCountListenerFunc func(count int)
+
+// SetChannelCount implements the ChannelCountListener interface.
+func (f ChannelCountListenerFunc) SetChannelCount(count int) {
+	f(count)
+}
+
+// OnChannelCount subscribes the given function as ChannelCountListener.
+func (n *notifier) OnChannelCount(f func(count int)) Unsubscribe {
+	return n.Subscribe(ChannelCountListenerFunc(f))
+}
+
+// DeviceNameListenerFunc wraps a function with the DeviceNameListener interface.
+type DeviceNameListenerFunc func(name string)
+
+// SetDeviceName implements the DeviceNameListener interface.
+func (f DeviceNameListenerFunc) SetDeviceName(name string) {
+	f(name)
+}
+
+// OnDeviceName subscribes the given function as DeviceNameListener.
+func (n *notifier) OnDeviceName(f func(name string)) Unsubscribe {
+	return n.Subscribe(DeviceNameListenerFunc(f))
+}
+
+// RXOnlyListenerFunc wraps a function with the RXOnlyListener interface.
+type RXOnlyListenerFunc func(value bool)
+
+// SetRXOnly implements the RXOnlyListener interface.
+func (f RXOnlyListenerFunc) SetRXOnly(value bool) {
+	f(value)
+}
+
+// OnRXOnly subscribes the given function as RXOnlyListener.
+func (n *notifier) OnRXOnly(f func(value bool)) Unsubscribe {
+	return n.Subscribe(RXOnlyListenerFunc(f))
+}
+
+// TXEnableListenerFunc wraps a function with the TXEnableListener interface.
+type TXEnableListenerFunc func(trx int, enabled bool)
+
+// SetTXEnable implements the TXEnableListener interface.
+func (f TXEnableListenerFunc) SetTXEnable(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnTXEnable subscribes the given function as TXEnableListener.
+func (n *notifier) OnTXEnable(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(TXEnableListenerFunc(f))
+}
+
+// ReadyListenerFunc wraps a function with the ReadyListener interface.
+type ReadyListenerFunc func()
+
+// Ready implements the ReadyListener interface.
+func (f ReadyListenerFunc) Ready() {
+	f()
+}
+
+// OnReady subscribes the given function as ReadyListener.
+func (n *notifier) OnReady(f func()) Unsubscribe {
+	return n.Subscribe(ReadyListenerFunc(f))
+}
+
+// TXFootswitchListenerFunc wraps a function with the TXFootswitchListener interface.
+type TXFootswitchListenerFunc func(trx int, pressed bool)
+
+// SetTXFootswitch implements the TXFootswitchListener interface.
+func (f TXFootswitchListenerFunc) SetTXFootswitch(trx int, pressed bool) {
+	f(trx, pressed)
+}
+
+// OnTXFootswitch subscribes the given function as TXFootswitchListener.
+func (n *notifier) OnTXFootswitch(f func(trx int, pressed bool)) Unsubscribe {
+	return n.Subscribe(TXFootswitchListenerFunc(f))
+}
+
+// StartListenerFunc wraps a function with the StartListener interface.
+type StartListenerFunc func()
+
+// Start implements the StartListener interface.
+func (f StartListenerFunc) Start() {
+	f()
+}
+
+// OnStart subscribes the given function as StartListener.
+func (n *notifier) OnStart(f func()) Unsubscribe {
+	return n.Subscribe(StartListenerFunc(f))
+}
+
+// StopListenerFunc wraps a function with the StopListener interface.
+type StopListenerFunc func()
+
+// Stop implements the StopListener interface.
+func (f StopListenerFunc) Stop() {
+	f()
+}
+
+// OnStop subscribes the given function as StopListener.
+func (n *notifier) OnStop(f func()) Unsubscribe {
+	return n.Subscribe(StopListenerFunc(f))
+}
+
+// DDSListenerFunc wraps a function with the DDSListener interface.
+type DDSListenerFunc func(trx int, frequency int)
+
+// SetDDS implements the DDSListener interface.
+func (f DDSListenerFunc) SetDDS(trx int, frequency int) {
+	f(trx, frequency)
+}
+
+// OnDDS subscribes the given function as DDSListener.
+func (n *notifier) OnDDS(f func(trx int, frequency int)) Unsubscribe {
+	return n.Subscribe(DDSListenerFunc(f))
+}
+
+// IFListenerFunc wraps a function with the IFListener interface.
+type IFListenerFunc func(trx int, vfo VFO, frequency int)
+
+// SetIF implements the IFListener interface.
+func (f IFListenerFunc) SetIF(trx int, vfo VFO, frequency int) {
+	f(trx, vfo, frequency)
+}
+
+// OnIF subscribes the given function as IFListener.
+func (n *notifier) OnIF(f func(trx int, vfo VFO, frequency int)) Unsubscribe {
+	return n.Subscribe(IFListenerFunc(f))
+}
+
+// RITEnableListenerFunc wraps a function with the RITEnableListener interface.
+type RITEnableListenerFunc func(trx int, enabled bool)
+
+// SetRITEnable implements the RITEnableListener interface.
+func (f RITEnableListenerFunc) SetRITEnable(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnRITEnable subscribes the given function as RITEnableListener.
+func (n *notifier) OnRITEnable(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(RITEnableListenerFunc(f))
+}
+
+// ModeListenerFunc wraps a function with the ModeListener interface.
+type ModeListenerFunc func(trx int, mode Mode)
+
+// SetMode implements the ModeListener interface.
+func (f ModeListenerFunc) SetMode(trx int, mode Mode) {
+	f(trx, mode)
+}
+
+// OnMode subscribes the given function as ModeListener.
+func (n *notifier) OnMode(f func(trx int, mode Mode)) Unsubscribe {
+	return n.Subscribe(ModeListenerFunc(f))
+}
+
+// RXEnableListenerFunc wraps a function with the RXEnableListener interface.
+type RXEnableListenerFunc func(trx int, enabled bool)
+
+// SetRXEnable implements the RXEnableListener interface.
+func (f RXEnableListenerFunc) SetRXEnable(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnRXEnable subscribes the given function as RXEnableListener.
+func (n *notifier) OnRXEnable(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(RXEnableListenerFunc(f))
+}
+
+// XITEnableListenerFunc wraps a function with the XITEnableListener interface.
+type XITEnableListenerFunc func(trx int, enabled bool)
+
+// SetXITEnable implements the XITEnableListener interface.
+func (f XITEnableListenerFunc) SetXITEnable(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnXITEnable subscribes the given function as XITEnableListener.
+func (n *notifier) OnXITEnable(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(XITEnableListenerFunc(f))
+}
+
+// SplitEnableListenerFunc wraps a function with the SplitEnableListener interface.
+type SplitEnableListenerFunc func(trx int, enabled bool)
+
+// SetSplitEnable implements the SplitEnableListener interface.
+func (f SplitEnableListenerFunc) SetSplitEnable(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnSplitEnable subscribes the given function as SplitEnableListener.
+func (n *notifier) OnSplitEnable(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(SplitEnableListenerFunc(f))
+}
+
+// RITOffsetListenerFunc wraps a function with the RITOffsetListener interface.
+type RITOffsetListenerFunc func(trx int, offset int)
+
+// SetRITOffset implements the RITOffsetListener interface.
+func (f RITOffsetListenerFunc) SetRITOffset(trx int, offset int) {
+	f(trx, offset)
+}
+
+// OnRITOffset subscribes the given function as RITOffsetListener.
+func (n *notifier) OnRITOffset(f func(trx int, offset int)) Unsubscribe {
+	return n.Subscribe(RITOffsetListenerFunc(f))
+}
+
+// XITOffsetListenerFunc wraps a function with the XITOffsetListener interface.
+type XITOffsetListenerFunc func(trx int, offset int)
+
+// SetXITOffset implements the XITOffsetListener interface.
+func (f XITOffsetListenerFunc) SetXITOffset(trx int, offset int) {
+	f(trx, offset)
+}
+
+// OnXITOffset subscribes the given function as XITOffsetListener.
+func (n *notifier) OnXITOffset(f func(trx int, offset int)) Unsubscribe {
+	return n.Subscribe(XITOffsetListenerFunc(f))
+}
+
+// RXChannelEnableListenerFunc wraps a function with the RXChannelEnableListener interface.
+type RXChannelEnableListenerFunc func(trx int, vfo VFO, enabled bool)
+
+// SetRXChannelEnable implements the RXChannelEnableListener interface.
+func (f RXChannelEnableListenerFunc) SetRXChannelEnable(trx int, vfo VFO, enabled bool) {
+	f(trx, vfo, enabled)
+}
+
+// OnRXChannelEnable subscribes the given function as RXChannelEnableListener.
+func (n *notifier) OnRXChannelEnable(f func(trx int, vfo VFO, enabled bool)) Unsubscribe {
+	return n.Subscribe(RXChannelEnableListenerFunc(f))
+}
+
+// RXFilterBandListenerFunc wraps a function with the RXFilterBandListener interface.
+type RXFilterBandListenerFunc func(trx int, min int, max int)
+
+// SetRXFilterBand implements the RXFilterBandListener interface.
+func (f RXFilterBandListenerFunc) SetRXFilterBand(trx int, min int, max int) {
+	f(trx, min, max)
+}
+
+// OnRXFilterBand subscribes the given function as RXFilterBandListener.
+func (n *notifier) OnRXFilterBand(f func(trx int, min int, max int)) Unsubscribe {
+	return n.Subscribe(RXFilterBandListenerFunc(f))
+}
+
+// RXSMeterListenerFunc wraps a function with the RXSMeterListener interface.
+type RXSMeterListenerFunc func(trx int, vfo VFO, level int)
+
+// SetRXSMeter implements the RXSMeterListener interface.
+func (f RXSMeterListenerFunc) SetRXSMeter(trx int, vfo VFO, level int) {
+	f(trx, vfo, level)
+}
+
+// OnRXSMeter subscribes the given function as RXSMeterListener.
+func (n *notifier) OnRXSMeter(f func(trx int, vfo VFO, level int)) Unsubscribe {
+	return n.Subscribe(RXSMeterListenerFunc(f))
+}
+
+// CWMacrosSpeedListenerFunc wraps a function with the CWMacrosSpeedListener interface.
+type CWMacrosSpeedListenerFunc func(wpm int)
+
+// SetCWMacrosSpeed implements the CWMacrosSpeedListener interface.
+func (f CWMacrosSpeedListenerFunc) SetCWMacrosSpeed(wpm int) {
+	f(wpm)
+}
+
+// OnCWMacrosSpeed subscribes the given function as CWMacrosSpeedListener.
+func (n *notifier) OnCWMacrosSpeed(f func(wpm int)) Unsubscribe {
+	return n.Subscribe(CWMacrosSpeedListenerFunc(f))
+}
+
+// CWMacrosDelayListenerFunc wraps a function with the CWMacrosDelayListener interface.
+type CWMacrosDelayListenerFunc func(delay int)
+
+// SetCWMacrosDelay implements the CWMacrosDelayListener interface.
+func (f CWMacrosDelayListenerFunc) SetCWMacrosDelay(delay int) {
+	f(delay)
+}
+
+// OnCWMacrosDelay subscribes the given function as CWMacrosDelayListener.
+func (n *notifier) OnCWMacrosDelay(f func(delay int)) Unsubscribe {
+	return n.Subscribe(CWMacrosDelayListenerFunc(f))
+}
+
+// CWMacrosEmptyListenerFunc wraps a function with the CWMacrosEmptyListener interface.
+type CWMacrosEmptyListenerFunc func()
+
+// CWMacrosEmpty implements the CWMacrosEmptyListener interface.
+func (f CWMacrosEmptyListenerFunc) CWMacrosEmpty() {
+	f()
+}
+
+// OnCWMacrosEmpty subscribes the given function as CWMacrosEmptyListener.
+func (n *notifier) OnCWMacrosEmpty(f func()) Unsubscribe {
+	return n.Subscribe(CWMacrosEmptyListenerFunc(f))
+}
+
+// TXListenerFunc wraps a function with the TXListener interface.
+type TXListenerFunc func(trx int, enabled bool)
+
+// SetTX implements the TXListener interface.
+func (f TXListenerFunc) SetTX(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnTX subscribes the given function as TXListener.
+func (n *notifier) OnTX(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(TXListenerFunc(f))
+}
+
+// TuneListenerFunc wraps a function with the TuneListener interface.
+type TuneListenerFunc func(trx int, enabled bool)
+
+// SetTune implements the TuneListener interface.
+func (f TuneListenerFunc) SetTune(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnTune subscribes the given function as TuneListener.
+func (n *notifier) OnTune(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(TuneListenerFunc(f))
+}
+
+// StartIQListenerFunc wraps a function with the StartIQListener interface.
+type StartIQListenerFunc func(trx int)
+
+// StartIQ implements the StartIQListener interface.
+func (f StartIQListenerFunc) StartIQ(trx int) {
+	f(trx)
+}
+
+// OnStartIQ subscribes the given function as StartIQListener.
+func (n *notifier) OnStartIQ(f func(trx int)) Unsubscribe {
+	return n.Subscribe(StartIQListenerFunc(f))
+}
+
+// StopIQListenerFunc wraps a function with the StopIQListener interface.
+type StopIQListenerFunc func(trx int)
+
+// StopIQ implements the StopIQListener interface.
+func (f StopIQListenerFunc) StopIQ(trx int) {
+	f(trx)
+}
+
+// OnStopIQ subscribes the given function as StopIQListener.
+func (n *notifier) OnStopIQ(f func(trx int)) Unsubscribe {
+	return n.Subscribe(StopIQListenerFunc(f))
+}
+
+// IQSampleRateListenerFunc wraps a function with the IQSampleRateListener interface.
+type IQSampleRateListenerFunc func(sampleRate IQSampleRate)
+
+// SetIQSampleRate implements the IQSampleRateListener interface.
+func (f IQSampleRateListenerFunc) SetIQSampleRate(sampleRate IQSampleRate) {
+	f(sampleRate)
+}
+
+// OnIQSampleRate subscribes the given function as IQSampleRateListener.
+func (n *notifier) OnIQSampleRate(f func(sampleRate IQSampleRate)) Unsubscribe {
+	return n.Subscribe(IQSampleRateListenerFunc(f))
+}
+
+// StartAudioListenerFunc wraps a function with the StartAudioListener interface.
+type StartAudioListenerFunc func(trx int)
+
+// StartAudio implements the StartAudioListener interface.
+func (f StartAudioListenerFunc) StartAudio(trx int) {
+	f(trx)
+}
+
+// OnStartAudio subscribes the given function as StartAudioListener.
+func (n *notifier) OnStartAudio(f func(trx int)) Unsubscribe {
+	return n.Subscribe(StartAudioListenerFunc(f))
+}
+
+// StopAudioListenerFunc wraps a function with the StopAudioListener interface.
+type StopAudioListenerFunc func(trx int)
+
+// StopAudio implements the StopAudioListener interface.
+func (f StopAudioListenerFunc) StopAudio(trx int) {
+	f(trx)
+}
+
+// OnStopAudio subscribes the given function as StopAudioListener.
+func (n *notifier) OnStopAudio(f func(trx int)) Unsubscribe {
+	return n.Subscribe(StopAudioListenerFunc(f))
+}
+
+// AudioSampleRateListenerFunc wraps a function with the AudioSampleRateListener interface.
+type AudioSampleRateListenerFunc func(sampleRate AudioSampleRate)
+
+// SetAudioSampleRate implements the AudioSampleRateListener interface.
+func (f AudioSampleRateListenerFunc) SetAudioSampleRate(sampleRate AudioSampleRate) {
+	f(sampleRate)
+}
+
+// OnAudioSampleRate subscribes the given function as AudioSampleRateListener.
+func (n *notifier) OnAudioSampleRate(f func(sampleRate AudioSampleRate)) Unsubscribe {
+	return n.Subscribe(AudioSampleRateListenerFunc(f))
+}
+
+// AudioStreamSampleTypeListenerFunc wraps a function with the AudioStreamSampleTypeListener interface.
+type AudioStreamSampleTypeListenerFunc func(sampleType SampleType)
+
+// SetAudioStreamSampleType implements the AudioStreamSampleTypeListener interface.
+func (f AudioStreamSampleTypeListenerFunc) SetAudioStreamSampleType(sampleType SampleType) {
+	f(sampleType)
+}
+
+// OnAudioStreamSampleType subscribes the given function as AudioStreamSampleTypeListener.
+func (n *notifier) OnAudioStreamSampleType(f func(sampleType SampleType)) Unsubscribe {
+	return n.Subscribe(AudioStreamSampleTypeListenerFunc(f))
+}
+
+// AudioStreamChannelsListenerFunc wraps a function with the AudioStreamChannelsListener interface.
+type AudioStreamChannelsListenerFunc func(count int)
+
+// SetAudioStreamChannels implements the AudioStreamChannelsListener interface.
+func (f AudioStreamChannelsListenerFunc) SetAudioStreamChannels(count int) {
+	f(count)
+}
+
+// OnAudioStreamChannels subscribes the given function as AudioStreamChannelsListener.
+func (n *notifier) OnAudioStreamChannels(f func(count int)) Unsubscribe {
+	return n.Subscribe(AudioStreamChannelsListenerFunc(f))
+}
+
+// TXPowerListenerFunc wraps a function with the TXPowerListener interface.
+type TXPowerListenerFunc func(watts float64)
+
+// SetTXPower implements the TXPowerListener interface.
+func (f TXPowerListenerFunc) SetTXPower(watts float64) {
+	f(watts)
+}
+
+// OnTXPower subscribes the given function as TXPowerListener.
+func (n *notifier) OnTXPower(f func(watts float64)) Unsubscribe {
+	return n.Subscribe(TXPowerListenerFunc(f))
+}
+
+// TXSWRListenerFunc wraps a function with the TXSWRListener interface.
+type TXSWRListenerFunc func(ratio float64)
+
+// SetTXSWR implements the TXSWRListener interface.
+func (f TXSWRListenerFunc) SetTXSWR(ratio float64) {
+	f(ratio)
+}
+
+// OnTXSWR subscribes the given function as TXSWRListener.
+func (n *notifier) OnTXSWR(f func(ratio float64)) Unsubscribe {
+	return n.Subscribe(TXSWRListenerFunc(f))
+}
+
+// VolumeListenerFunc wraps a function with the VolumeListener interface.
+type VolumeListenerFunc func(dB int)
+
+// SetVolume implements the VolumeListener interface.
+func (f VolumeListenerFunc) SetVolume(dB int) {
+	f(dB)
+}
+
+// OnVolume subscribes the given function as VolumeListener.
+func (n *notifier) OnVolume(f func(dB int)) Unsubscribe {
+	return n.Subscribe(VolumeListenerFunc(f))
+}
+
+// SquelchEnableListenerFunc wraps a function with the SquelchEnableListener interface.
+type SquelchEnableListenerFunc func(trx int, enabled bool)
+
+// SetSquelchEnable implements the SquelchEnableListener interface.
+func (f SquelchEnableListenerFunc) SetSquelchEnable(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnSquelchEnable subscribes the given function as SquelchEnableListener.
+func (n *notifier) OnSquelchEnable(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(SquelchEnableListenerFunc(f))
+}
+
+// SquelchLevelListenerFunc wraps a function with the SquelchLevelListener interface.
+type SquelchLevelListenerFunc func(dB int)
+
+// SetSquelchLevel implements the SquelchLevelListener interface.
+func (f SquelchLevelListenerFunc) SetSquelchLevel(dB int) {
+	f(dB)
+}
+
+// OnSquelchLevel subscribes the given function as SquelchLevelListener.
+func (n *notifier) OnSquelchLevel(f func(dB int)) Unsubscribe {
+	return n.Subscribe(SquelchLevelListenerFunc(f))
+}
+
+// VFOFrequencyListenerFunc wraps a function with the VFOFrequencyListener interface.
+type VFOFrequencyListenerFunc func(trx int, vfo VFO, frequency int)
+
+// SetVFOFrequency implements the VFOFrequencyListener interface.
+func (f VFOFrequencyListenerFunc) SetVFOFrequency(trx int, vfo VFO, frequency int) {
+	f(trx, vfo, frequency)
+}
+
+// OnVFOFrequency subscribes the given function as VFOFrequencyListener.
+func (n *notifier) OnVFOFrequency(f func(trx int, vfo VFO, frequency int)) Unsubscribe {
+	return n.Subscribe(VFOFrequencyListenerFunc(f))
+}
+
+// AppFocusListenerFunc wraps a function with the AppFocusListener interface.
+type AppFocusListenerFunc func(focussed bool)
+
+// SetAppFocus implements the AppFocusListener interface.
+func (f AppFocusListenerFunc) SetAppFocus(focussed bool) {
+	f(focussed)
+}
+
+// OnAppFocus subscribes the given function as AppFocusListener.
+func (n *notifier) OnAppFocus(f func(focussed bool)) Unsubscribe {
+	return n.Subscribe(AppFocusListenerFunc(f))
+}
+
+// MuteListenerFunc wraps a function with the MuteListener interface.
+type MuteListenerFunc func(muted bool)
+
+// SetMute implements the MuteListener interface.
+func (f MuteListenerFunc) SetMute(muted bool) {
+	f(muted)
+}
+
+// OnMute subscribes the given function as MuteListener.
+func (n *notifier) OnMute(f func(muted bool)) Unsubscribe {
+	return n.Subscribe(MuteListenerFunc(f))
+}
+
+// RXMuteListenerFunc wraps a function with the RXMuteListener interface.
+type RXMuteListenerFunc func(trx int, muted bool)
+
+// SetRXMute implements the RXMuteListener interface.
+func (f RXMuteListenerFunc) SetRXMute(trx int, muted bool) {
+	f(trx, muted)
+}
+
+// OnRXMute subscribes the given function as RXMuteListener.
+func (n *notifier) OnRXMute(f func(trx int, muted bool)) Unsubscribe {
+	return n.Subscribe(RXMuteListenerFunc(f))
+}
+
+// CTCSSEnableListenerFunc wraps a function with the CTCSSEnableListener interface.
+type CTCSSEnableListenerFunc func(trx int, enabled bool)
+
+// SetCTCSSEnable implements the CTCSSEnableListener interface.
+func (f CTCSSEnableListenerFunc) SetCTCSSEnable(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnCTCSSEnable subscribes the given function as CTCSSEnableListener.
+func (n *notifier) OnCTCSSEnable(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(CTCSSEnableListenerFunc(f))
+}
+
+// CTCSSModeListenerFunc wraps a function with the CTCSSModeListener interface.
+type CTCSSModeListenerFunc func(trx int, mode CTCSSMode)
+
+// SetCTCSSMode implements the CTCSSModeListener interface.
+func (f CTCSSModeListenerFunc) SetCTCSSMode(trx int, mode CTCSSMode) {
+	f(trx, mode)
+}
+
+// OnCTCSSMode subscribes the given function as CTCSSModeListener.
+func (n *notifier) OnCTCSSMode(f func(trx int, mode CTCSSMode)) Unsubscribe {
+	return n.Subscribe(CTCSSModeListenerFunc(f))
+}
+
+// CTCSSRXToneListenerFunc wraps a function with the CTCSSRXToneListener interface.
+type CTCSSRXToneListenerFunc func(trx int, tone CTCSSTone)
+
+// SetCTCSSRXTone implements the CTCSSRXToneListener interface.
+func (f CTCSSRXToneListenerFunc) SetCTCSSRXTone(trx int, tone CTCSSTone) {
+	f(trx, tone)
+}
+
+// OnCTCSSRXTone subscribes the given function as CTCSSRXToneListener.
+func (n *notifier) OnCTCSSRXTone(f func(trx int, tone CTCSSTone)) Unsubscribe {
+	return n.Subscribe(CTCSSRXToneListenerFunc(f))
+}
+
+// CTCSSTXToneListenerFunc wraps a function with the CTCSSTXToneListener interface.
+type CTCSSTXToneListenerFunc func(trx int, tone CTCSSTone)
+
+// SetCTCSSTXTone implements the CTCSSTXToneListener interface.
+func (f CTCSSTXToneListenerFunc) SetCTCSSTXTone(trx int, tone CTCSSTone) {
+	f(trx, tone)
+}
+
+// OnCTCSSTXTone subscribes the given function as CTCSSTXToneListener.
+func (n *notifier) OnCTCSSTXTone(f func(trx int, tone CTCSSTone)) Unsubscribe {
+	return n.Subscribe(CTCSSTXToneListenerFunc(f))
+}
+
+// CTCSSLevelListenerFunc wraps a function with the CTCSSLevelListener interface.
+type CTCSSLevelListenerFunc func(trx int, percent int)
+
+// SetCTCSSLevel implements the CTCSSLevelListener interface.
+func (f CTCSSLevelListenerFunc) SetCTCSSLevel(trx int, percent int) {
+	f(trx, percent)
+}
+
+// OnCTCSSLevel subscribes the given function as CTCSSLevelListener.
+func (n *notifier) OnCTCSSLevel(f func(trx int, percent int)) Unsubscribe {
+	return n.Subscribe(CTCSSLevelListenerFunc(f))
+}
+
+// ECoderSwitchRXListenerFunc wraps a function with the ECoderSwitchRXListener interface.
+type ECoderSwitchRXListenerFunc func(ecoder int, trx int)
+
+// SetECoderSwitchRX implements the ECoderSwitchRXListener interface.
+func (f ECoderSwitchRXListenerFunc) SetECoderSwitchRX(ecoder int, trx int) {
+	f(ecoder, trx)
+}
+
+// OnECoderSwitchRX subscribes the given function as ECoderSwitchRXListener.
+func (n *notifier) OnECoderSwitchRX(f func(ecoder int, trx int)) Unsubscribe {
+	return n.Subscribe(ECoderSwitchRXListenerFunc(f))
+}
+
+// ECoderSwitchChannelListenerFunc wraps a function with the ECoderSwitchChannelListener interface.
+type ECoderSwitchChannelListenerFunc func(ecoder int, vfo VFO)
+
+// SetECoderSwitchChannel implements the ECoderSwitchChannelListener interface.
+func (f ECoderSwitchChannelListenerFunc) SetECoderSwitchChannel(ecoder int, vfo VFO) {
+	f(ecoder, vfo)
+}
+
+// OnECoderSwitchChannel subscribes the given function as ECoderSwitchChannelListener.
+func (n *notifier) OnECoderSwitchChannel(f func(ecoder int, vfo VFO)) Unsubscribe {
+	return n.Subscribe(ECoderSwitchChannelListenerFunc(f))
+}
+
+// RXVolumeListenerFunc wraps a function with the RXVolumeListener interface.
+type RXVolumeListenerFunc func(trx int, vfo VFO, dB int)
+
+// SetRXVolume implements the RXVolumeListener interface.
+func (f RXVolumeListenerFunc) SetRXVolume(trx int, vfo VFO, dB int) {
+	f(trx, vfo, dB)
+}
+
+// OnRXVolume subscribes the given function as RXVolumeListener.
+func (n *notifier) OnRXVolume(f func(trx int, vfo VFO, dB int)) Unsubscribe {
+	return n.Subscribe(RXVolumeListenerFunc(f))
+}
+
+// RXBalanceListenerFunc wraps a function with the RXBalanceListener interface.
+type RXBalanceListenerFunc func(trx int, vfo VFO, dB int)
+
+// SetRXBalance implements the RXBalanceListener interface.
+func (f RXBalanceListenerFunc) SetRXBalance(trx int, vfo VFO, dB int) {
+	f(trx, vfo, dB)
+}
+
+// OnRXBalance subscribes the given function as RXBalanceListener.
+func (n *notifier) OnRXBalance(f func(trx int, vfo VFO, dB int)) Unsubscribe {
+	return n.Subscribe(RXBalanceListenerFunc(f))
+}
+
+// RXSensorsListenerFunc wraps a function with the RXSensorsListener interface.
+type RXSensorsListenerFunc func(trx int, dBm float64)
+
+// SetRXSensors implements the RXSensorsListener interface.
+func (f RXSensorsListenerFunc) SetRXSensors(trx int, dBm float64) {
+	f(trx, dBm)
+}
+
+// OnRXSensors subscribes the given function as RXSensorsListener.
+func (n *notifier) OnRXSensors(f func(trx int, dBm float64)) Unsubscribe {
+	return n.Subscribe(RXSensorsListenerFunc(f))
+}
+
+// RXChannelSensorsListenerFunc wraps a function with the RXChannelSensorsListener interface.
+type RXChannelSensorsListenerFunc func(trx int, vfo VFO, dBm float64)
+
+// SetRXChannelSensors implements the RXChannelSensorsListener interface.
+func (f RXChannelSensorsListenerFunc) SetRXChannelSensors(trx int, vfo VFO, dBm float64) {
+	f(trx, vfo, dBm)
+}
+
+// OnRXChannelSensors subscribes the given function as RXChannelSensorsListener.
+func (n *notifier) OnRXChannelSensors(f func(trx int, vfo VFO, dBm float64)) Unsubscribe {
+	return n.Subscribe(RXChannelSensorsListenerFunc(f))
+}
+
+// TXSensorsListenerFunc wraps a function with the TXSensorsListener interface.
+type TXSensorsListenerFunc func(trx int, micdBm float64, txRMS float64, txPeak float64, swr float64)
+
+// SetTXSensors implements the TXSensorsListener interface.
+func (f TXSensorsListenerFunc) SetTXSensors(trx int, micdBm float64, txRMS float64, txPeak float64, swr float64) {
+	f(trx, micdBm, txRMS, txPeak, swr)
+}
+
+// OnTXSensors subscribes the given function as TXSensorsListener.
+func (n *notifier) OnTXSensors(f func(trx int, micdBm float64, txRMS float64, txPeak float64, swr float64)) Unsubscribe {
+	return n.Subscribe(TXSensorsListenerFunc(f))
+}
+
+// RXNBEnableListenerFunc wraps a function with the RXNBEnableListener interface.
+type RXNBEnableListenerFunc func(trx int, enabled bool)
+
+// SetRXNBEnable implements the RXNBEnableListener interface.
+func (f RXNBEnableListenerFunc) SetRXNBEnable(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnRXNBEnable subscribes the given function as RXNBEnableListener.
+func (n *notifier) OnRXNBEnable(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(RXNBEnableListenerFunc(f))
+}
+
+// RXNBParamsListenerFunc wraps a function with the RXNBParamsListener interface.
+type RXNBParamsListenerFunc func(trx int, threshold int, impulseLength int)
+
+// SetRXNBParams implements the RXNBParamsListener interface.
+func (f RXNBParamsListenerFunc) SetRXNBParams(trx int, threshold int, impulseLength int) {
+	f(trx, threshold, impulseLength)
+}
+
+// OnRXNBParams subscribes the given function as RXNBParamsListener.
+func (n *notifier) OnRXNBParams(f func(trx int, threshold int, impulseLength int)) Unsubscribe {
+	return n.Subscribe(RXNBParamsListenerFunc(f))
+}
+
+// RXBinEnableListenerFunc wraps a function with the RXBinEnableListener interface.
+type RXBinEnableListenerFunc func(trx int, enabled bool)
+
+// SetRXBinEnable implements the RXBinEnableListener interface.
+func (f RXBinEnableListenerFunc) SetRXBinEnable(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnRXBinEnable subscribes the given function as RXBinEnableListener.
+func (n *notifier) OnRXBinEnable(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(RXBinEnableListenerFunc(f))
+}
+
+// RXNREnableListenerFunc wraps a function with the RXNREnableListener interface.
+type RXNREnableListenerFunc func(trx int, enabled bool)
+
+// SetRXNREnable implements the RXNREnableListener interface.
+func (f RXNREnableListenerFunc) SetRXNREnable(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnRXNREnable subscribes the given function as RXNREnableListener.
+func (n *notifier) OnRXNREnable(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(RXNREnableListenerFunc(f))
+}
+
+// RXANCEnableListenerFunc wraps a function with the RXANCEnableListener interface.
+type RXANCEnableListenerFunc func(trx int, enabled bool)
+
+// SetRXANCEnable implements the RXANCEnableListener interface.
+func (f RXANCEnableListenerFunc) SetRXANCEnable(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnRXANCEnable subscribes the given function as RXANCEnableListener.
+func (n *notifier) OnRXANCEnable(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(RXANCEnableListenerFunc(f))
+}
+
+// RXANFEnableListenerFunc wraps a function with the RXANFEnableListener interface.
+type RXANFEnableListenerFunc func(trx int, enabled bool)
+
+// SetRXANFEnable implements the RXANFEnableListener interface.
+func (f RXANFEnableListenerFunc) SetRXANFEnable(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnRXANFEnable subscribes the given function as RXANFEnableListener.
+func (n *notifier) OnRXANFEnable(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(RXANFEnableListenerFunc(f))
+}
+
+// RXAPFEnableListenerFunc wraps a function with the RXAPFEnableListener interface.
+type RXAPFEnableListenerFunc func(trx int, enabled bool)
+
+// SetRXAPFEnable implements the RXAPFEnableListener interface.
+func (f RXAPFEnableListenerFunc) SetRXAPFEnable(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnRXAPFEnable subscribes the given function as RXAPFEnableListener.
+func (n *notifier) OnRXAPFEnable(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(RXAPFEnableListenerFunc(f))
+}
+
+// RXDSEEnableListenerFunc wraps a function with the RXDSEEnableListener interface.
+type RXDSEEnableListenerFunc func(trx int, enabled bool)
+
+// SetRXDSEEnable implements the RXDSEEnableListener interface.
+func (f RXDSEEnableListenerFunc) SetRXDSEEnable(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnRXDSEEnable subscribes the given function as RXDSEEnableListener.
+func (n *notifier) OnRXDSEEnable(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(RXDSEEnableListenerFunc(f))
+}
+
+// RXNFEnableListenerFunc wraps a function with the RXNFEnableListener interface.
+type RXNFEnableListenerFunc func(trx int, enabled bool)
+
+// SetRXNFEnable implements the RXNFEnableListener interface.
+func (f RXNFEnableListenerFunc) SetRXNFEnable(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnRXNFEnable subscribes the given function as RXNFEnableListener.
+func (n *notifier) OnRXNFEnable(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(RXNFEnableListenerFunc(f))
+}
+
+// TXFrequencyListenerFunc wraps a function with the TXFrequencyListener interface.
+type TXFrequencyListenerFunc func(trx int, frequency int)
+
+// SetTXFrequency implements the TXFrequencyListener interface.
+func (f TXFrequencyListenerFunc) SetTXFrequency(trx int, frequency int) {
+	f(trx, frequency)
+}
+
+// OnTXFrequency subscribes the given function as TXFrequencyListener.
+func (n *notifier) OnTXFrequency(f func(trx int, frequency int)) Unsubscribe {
+	return n.Subscribe(TXFrequencyListenerFunc(f))
+}
+
+// AGCModeListenerFunc wraps a function with the AGCModeListener interface.
+type AGCModeListenerFunc func(trx int, mode AGCMode)
+
+// SetAGCMode implements the AGCModeListener interface.
+func (f AGCModeListenerFunc) SetAGCMode(trx int, mode AGCMode) {
+	f(trx, mode)
+}
+
+// OnAGCMode subscribes the given function as AGCModeListener.
+func (n *notifier) OnAGCMode(f func(trx int, mode AGCMode)) Unsubscribe {
+	return n.Subscribe(AGCModeListenerFunc(f))
+}
+
+// AGCGainListenerFunc wraps a function with the AGCGainListener interface.
+type AGCGainListenerFunc func(trx int, dB int)
+
+// SetAGCGain implements the AGCGainListener interface.
+func (f AGCGainListenerFunc) SetAGCGain(trx int, dB int) {
+	f(trx, dB)
+}
+
+// OnAGCGain subscribes the given function as AGCGainListener.
+func (n *notifier) OnAGCGain(f func(trx int, dB int)) Unsubscribe {
+	return n.Subscribe(AGCGainListenerFunc(f))
+}
+
+// LockListenerFunc wraps a function with the LockListener interface.
+type LockListenerFunc func(trx int, enabled bool)
+
+// SetLock implements the LockListener interface.
+func (f LockListenerFunc) SetLock(trx int, enabled bool) {
+	f(trx, enabled)
+}
+
+// OnLock subscribes the given function as LockListener.
+func (n *notifier) OnLock(f func(trx int, enabled bool)) Unsubscribe {
+	return n.Subscribe(LockListenerFunc(f))
+}
+
+// VFOLockListenerFunc wraps a function with the VFOLockListener interface.
+type VFOLockListenerFunc func(trx int, vfo VFO, enabled bool)
+
+// SetVFOLock implements the VFOLockListener interface.
+func (f VFOLockListenerFunc) SetVFOLock(trx int, vfo VFO, enabled bool) {
+	f(trx, vfo, enabled)
+}
+
+// OnVFOLock subscribes the given function as VFOLockListener.
+func (n *notifier) OnVFOLock(f func(trx int, vfo VFO, enabled bool)) Unsubscribe {
+	return n.Subscribe(VFOLockListenerFunc(f))
+}
+
+// MonitorEnableListenerFunc wraps a function with the MonitorEnableListener interface.
+type MonitorEnableListenerFunc func(enabled bool)
+
+// SetMonitorEnable implements the MonitorEnableListener interface.
+func (f MonitorEnableListenerFunc) SetMonitorEnable(enabled bool) {
+	f(enabled)
+}
+
+// OnMonitorEnable subscribes the given function as MonitorEnableListener.
+func (n *notifier) OnMonitorEnable(f func(enabled bool)) Unsubscribe {
+	return n.Subscribe(MonitorEnableListenerFunc(f))
+}
+
+// MonitorVolumeListenerFunc wraps a function with the MonitorVolumeListener interface.
+type MonitorVolumeListenerFunc func(dB int)
+
+// SetMonitorVolume implements the MonitorVolumeListener interface.
+func (f MonitorVolumeListenerFunc) SetMonitorVolume(dB int) {
+	f(dB)
+}
+
+// OnMonitorVolume subscribes the given function as MonitorVolumeListener.
+func (n *notifier) OnMonitorVolume(f func(dB int)) Unsubscribe {
+	return n.Subscribe(MonitorVolumeListenerFunc(f))
+}
+
+// DIGLOffsetListenerFunc wraps a function with the DIGLOffsetListener interface.
+type DIGLOffsetListenerFunc func(offset int)
+
+// SetDIGLOffset implements the DIGLOffsetListener interface.
+func (f DIGLOffsetListenerFunc) SetDIGLOffset(offset int) {
+	f(offset)
+}
+
+// OnDIGLOffset subscribes the given function as DIGLOffsetListener.
+func (n *notifier) OnDIGLOffset(f func(offset int)) Unsubscribe {
+	return n.Subscribe(DIGLOffsetListenerFunc(f))
+}
+
+// DIGUOffsetListenerFunc wraps a function with the DIGUOffsetListener interface.
+type DIGUOffsetListenerFunc func(offset int)
+
+// SetDIGUOffset implements the DIGUOffsetListener interface.
+func (f DIGUOffsetListenerFunc) SetDIGUOffset(offset int) {
+	f(offset)
+}
+
+// OnDIGUOffset subscribes the given function as DIGUOffsetListener.
+func (n *notifier) OnDIGUOffset(f func(offset int)) Unsubscribe {
+	return n.Subscribe(DIGUOffsetListenerFunc(f))
+}
+
+// TXStreamAudioBufferingListenerFunc wraps a function with the TXStreamAudioBufferingListener interface.
+type TXStreamAudioBufferingListenerFunc func(milliseconds int)
+
+// SetTXStreamAudioBuffering implements the TXStreamAudioBufferingListener interface.
+func (f TXStreamAudioBufferingListenerFunc) SetTXStreamAudioBuffering(milliseconds int) {
+	f(milliseconds)
+}
+
+// OnTXStreamAudioBuffering subscribes the given function as TXStreamAudioBufferingListener.
+func (n *notifier) OnTXStreamAudioBuffering(f func(milliseconds int)) Unsubscribe {
+	return n.Subscribe(TXStreamAudioBufferingListenerFunc(f))
+}
+
+// ClickedOnSpotListenerFunc wraps a function with the ClickedOnSpotListener interface.
+type ClickedOnSpotListenerFunc func(callsign string, frequency int)
+
+// ClickedOnSpot implements the ClickedOnSpotListener interface.
+func (f ClickedOnSpotListenerFunc) ClickedOnSpot(callsign string, frequency int) {
+	f(callsign, frequency)
+}
+
+// OnClickedOnSpot subscribes the given function as ClickedOnSpotListener.
+func (n *notifier) OnClickedOnSpot(f func(callsign string, frequency int)) Unsubscribe {
+	return n.Subscribe(ClickedOnSpotListenerFunc(f))
+}
+
+// RXClickedOnSpotListenerFunc wraps a function with the RXClickedOnSpotListener interface.
+type RXClickedOnSpotListenerFunc func(trx int, vfo VFO, callsign string, frequency int)
+
+// RXClickedOnSpot implements the RXClickedOnSpotListener interface.
+func (f RXClickedOnSpotListenerFunc) RXClickedOnSpot(trx int, vfo VFO, callsign string, frequency int) {
+	f(trx, vfo, callsign, frequency)
+}
+
+// OnRXClickedOnSpot subscribes the given function as RXClickedOnSpotListener.
+func (n *notifier) OnRXClickedOnSpot(f func(trx int, vfo VFO, callsign string, frequency int)) Unsubscribe {
+	return n.Subscribe(RXClickedOnSpotListenerFunc(f))
+}
@@ -0,0 +1,36 @@
+package client
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubscribe_TypedListener(t *testing.T) {
+	n := newNotifier(nil, make(chan struct{}))
+	var frequencies []int
+	unsubscribe := n.OnVFOFrequency(func(trx int, vfo VFO, frequency int) {
+		frequencies = append(frequencies, frequency)
+	})
+
+	n.handleIncomingMessage(NewCommandMessage("vfo", 0, 0, 7000000), n.listeners())
+	unsubscribe()
+	unsubscribe()
+	n.handleIncomingMessage(NewCommandMessage("vfo", 0, 0, 7010000), n.listeners())
+
+	assert.Equal(t, []int{7000000}, frequencies)
+	assert.Empty(t, n.listeners())
+}
+
+func TestSubscribe_KeepsOtherListeners(t *testing.T) {
+	n := newNotifier(nil, make(chan struct{}))
+	count := 0
+	first := n.OnReady(func() { count++ })
+	n.OnReady(func() { count += 10 })
+
+	first()
+	n.handleIncomingMessage(NewCommandMessage("ready"), n.listeners())
+
+	assert.Equal(t, 10, count)
+	assert.Len(t, n.listeners(), 1)
+}
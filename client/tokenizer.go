@@ -0,0 +1,219 @@
+package client
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// The TCI protocol uses these characters to separate the parts of a text message.
+const (
+	nameSeparator     = ':'
+	argumentSeparator = ','
+	messageTerminator = ';'
+)
+
+// escapes maps the reserved characters to the characters that replace them within an argument.
+var escapes = map[byte]byte{
+	nameSeparator:     '^',
+	argumentSeparator: '~',
+	messageTerminator: '*',
+}
+
+// unescapes maps the escape characters to the reserved characters they replace.
+var unescapes = map[byte]byte{
+	'^': nameSeparator,
+	'~': argumentSeparator,
+	'*': messageTerminator,
+}
+
+// ParseError describes a syntax error in a TCI text message.
+type ParseError struct {
+	// Offset is the position of the error in bytes, counted from the start of the input.
+	Offset int
+	// Reason describes the error.
+	Reason string
+	// Err is the underlying error, e.g. io.ErrUnexpectedEOF, or nil.
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("invalid message at position %d: %s", e.Offset, e.Reason)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Tokenizer reads TCI text messages from a stream. A stream may contain any number of messages, optionally separated
+// by white space. Within the arguments, the escape characters ^, ~, and * are replaced by :, ,, and ; respectively.
+type Tokenizer struct {
+	r      *bufio.Reader
+	offset int
+}
+
+// NewTokenizer returns a new Tokenizer that reads from the given reader.
+func NewTokenizer(r io.Reader) *Tokenizer {
+	return &Tokenizer{r: bufio.NewReader(r)}
+}
+
+// Next returns the next message of the stream. At the end of the stream, Next returns io.EOF.
+// If the next message is invalid, Next returns a *ParseError and skips the rest of the invalid message,
+// i.e. the following call of Next continues with the next message.
+func (t *Tokenizer) Next() (Message, error) {
+	err := t.skipWhitespace()
+	if err != nil {
+		return Message{}, err
+	}
+
+	start := t.offset
+	name, terminator, err := t.readName()
+	if err != nil {
+		return Message{}, err
+	}
+	if name == "" {
+		err = t.errorf(start, nil, "missing message name")
+		t.skipMessage(terminator)
+		return Message{}, err
+	}
+
+	args := []string{}
+	for terminator != messageTerminator {
+		var arg string
+		arg, terminator, err = t.readArgument()
+		if err != nil {
+			return Message{}, err
+		}
+		args = append(args, arg)
+	}
+
+	return Message{name: strings.ToLower(name), args: args}, nil
+}
+
+func (t *Tokenizer) readByte() (byte, error) {
+	b, err := t.r.ReadByte()
+	if err == nil {
+		t.offset++
+	}
+	return b, err
+}
+
+func (t *Tokenizer) skipWhitespace() error {
+	for {
+		b, err := t.r.ReadByte()
+		if err != nil {
+			return err
+		}
+		if !isWhitespace(b) {
+			return t.r.UnreadByte()
+		}
+		t.offset++
+	}
+}
+
+// skipMessage skips everything up to and including the next message terminator, unless the given last byte already was the terminator.
+func (t *Tokenizer) skipMessage(last byte) {
+	for last != messageTerminator {
+		var err error
+		last, err = t.readByte()
+		if err != nil {
+			return
+		}
+	}
+}
+
+func (t *Tokenizer) readName() (string, byte, error) {
+	var name strings.Builder
+	for {
+		offset := t.offset
+		b, err := t.readByte()
+		if err == io.EOF {
+			return "", 0, t.errorf(offset, io.ErrUnexpectedEOF, "unterminated message")
+		}
+		if err != nil {
+			return "", 0, err
+		}
+		switch {
+		case b == nameSeparator || b == messageTerminator:
+			return name.String(), b, nil
+		case isNameCharacter(b):
+			name.WriteByte(b)
+		default:
+			err = t.errorf(offset, nil, fmt.Sprintf("unexpected character %q in message name", b))
+			t.skipMessage(b)
+			return "", 0, err
+		}
+	}
+}
+
+func (t *Tokenizer) readArgument() (string, byte, error) {
+	var arg strings.Builder
+	for {
+		offset := t.offset
+		b, err := t.readByte()
+		if err == io.EOF {
+			return "", 0, t.errorf(offset, io.ErrUnexpectedEOF, "unterminated message")
+		}
+		if err != nil {
+			return "", 0, err
+		}
+		switch {
+		case b == argumentSeparator || b == messageTerminator:
+			return arg.String(), b, nil
+		case b < ' ' && !isWhitespace(b):
+			err = t.errorf(offset, nil, fmt.Sprintf("unexpected control character %q in argument", b))
+			t.skipMessage(b)
+			return "", 0, err
+		default:
+			if unescaped, ok := unescapes[b]; ok {
+				b = unescaped
+			}
+			arg.WriteByte(b)
+		}
+	}
+}
+
+func (t *Tokenizer) errorf(offset int, err error, reason string) error {
+	return &ParseError{Offset: offset, Reason: reason, Err: err}
+}
+
+func isWhitespace(b byte) bool {
+	return b == ' ' || b == '\t' || b == '\r' || b == '\n'
+}
+
+func isNameCharacter(b byte) bool {
+	return (b >= 'a' && b <= 'z') || (b >= 'A' && b <= 'Z') || (b >= '0' && b <= '9') || b == '_'
+}
+
+// ParseTextMessages interprets the given string as a sequence of TCI messages, e.g. the content of a websocket frame.
+func ParseTextMessages(s string) ([]Message, error) {
+	var result []Message
+	tokenizer := NewTokenizer(strings.NewReader(s))
+	for {
+		msg, err := tokenizer.Next()
+		if err == io.EOF {
+			return result, nil
+		}
+		if err != nil {
+			return result, err
+		}
+		result = append(result, msg)
+	}
+}
+
+// escapeArgument replaces the reserved characters in the given argument with their escape characters.
+func escapeArgument(arg string) string {
+	if !strings.ContainsAny(arg, ":,;") {
+		return arg
+	}
+	var result strings.Builder
+	for i := 0; i < len(arg); i++ {
+		b := arg[i]
+		if escaped, ok := escapes[b]; ok {
+			b = escaped
+		}
+		result.WriteByte(b)
+	}
+	return result.String()
+}
@@ -0,0 +1,88 @@
+package client
+
+import (
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTextMessages(t *testing.T) {
+	messages, err := ParseTextMessages("vfo:0,0,7000000;\r\nmodulation:0,cw; ready;")
+
+	require.NoError(t, err)
+	assert.Equal(t, []Message{
+		NewCommandMessage("vfo", 0, 0, 7000000),
+		NewCommandMessage("modulation", 0, "cw"),
+		NewCommandMessage("ready"),
+	}, messages)
+}
+
+func TestParseTextMessage_Arguments(t *testing.T) {
+	tt := []struct {
+		value    string
+		expected []string
+	}{
+		{value: "cw_macros:0,CQ DE DL1ABC;", expected: []string{"0", "CQ DE DL1ABC"}},
+		{value: "cw_macros:0,RST^ 599~ TU*;", expected: []string{"0", "RST: 599, TU;"}},
+		{value: "spot:DL1ABC,cw,7010000,0,my_text;", expected: []string{"DL1ABC", "cw", "7010000", "0", "my_text"}},
+		{value: "spot:DL1ABC,cw,7010000,0,;", expected: []string{"DL1ABC", "cw", "7010000", "0", ""}},
+		{value: "modulations_list:AM,SAM,DSB;", expected: []string{"AM", "SAM", "DSB"}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.value, func(t *testing.T) {
+			actual, err := ParseTextMessage(tc.value)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, actual.Args())
+			assert.Equal(t, tc.value, actual.String())
+		})
+	}
+}
+
+func TestParseTextMessage_Errors(t *testing.T) {
+	tt := []struct {
+		value  string
+		offset int
+		reason string
+	}{
+		{value: "", offset: 0, reason: "empty message"},
+		{value: "vfo:0,0", offset: 7, reason: "unterminated message"},
+		{value: "  v-o:0;", offset: 3, reason: "unexpected character '-' in message name"},
+		{value: ":0;", offset: 0, reason: "missing message name"},
+		{value: "cw_macros:0,a\x00b;", offset: 13, reason: "unexpected control character '\\x00' in argument"},
+		{value: "start;stop;", offset: 6, reason: "more than one message"},
+	}
+	for _, tc := range tt {
+		t.Run(tc.value, func(t *testing.T) {
+			_, err := ParseTextMessage(tc.value)
+			var parseErr *ParseError
+			require.True(t, errors.As(err, &parseErr), "%v", err)
+			assert.Equal(t, tc.offset, parseErr.Offset)
+			assert.Equal(t, tc.reason, parseErr.Reason)
+		})
+	}
+}
+
+func TestTokenizer_ContinuesAfterError(t *testing.T) {
+	tokenizer := NewTokenizer(strings.NewReader("start;v/o:0,0,7000;stop;dds:0"))
+
+	msg, err := tokenizer.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "start", msg.Name())
+
+	_, err = tokenizer.Next()
+	assert.Error(t, err)
+
+	msg, err = tokenizer.Next()
+	require.NoError(t, err)
+	assert.Equal(t, "stop", msg.Name())
+
+	_, err = tokenizer.Next()
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	_, err = tokenizer.Next()
+	assert.Equal(t, io.EOF, err)
+}
@@ -4,11 +4,8 @@ import (
 	"context"
 	"fmt"
 	"log"
-	"net"
 	"os"
 	"os/signal"
-	"strconv"
-	"strings"
 	"syscall"
 	"time"
 
@@ -17,10 +14,13 @@ import (
 )
 
 var rootFlags = struct {
-	hostAddress string
-	trx         int
-	reconnect   bool
-	trace       bool
+	hostAddress       string
+	trx               int
+	reconnect         bool
+	reconnectDelay    time.Duration
+	reconnectMaxDelay time.Duration
+	trace             bool
+	record            string
 }{}
 
 var rootCmd = &cobra.Command{
@@ -38,36 +38,42 @@ func Execute() {
 }
 
 func init() {
-	rootCmd.PersistentFlags().StringVar(&rootFlags.hostAddress, "host", "localhost:40001", "connect to this TCI host")
+	rootCmd.PersistentFlags().StringVar(&rootFlags.hostAddress, "host", "localhost:40001", "connect to this TCI host (host[:port], ws:// or wss:// URL)")
 	rootCmd.PersistentFlags().IntVar(&rootFlags.trx, "trx", 0, "use this TRX")
-	rootCmd.PersistentFlags().BoolVar(&rootFlags.reconnect, "reconnect", false, "try to reconnect if the TCI connection failed")
+	rootCmd.PersistentFlags().BoolVar(&rootFlags.reconnect, "reconnect", false, "try to reconnect if the TCI connection failed, see --reconnect-delay and --reconnect-max-delay")
+	rootCmd.PersistentFlags().DurationVar(&rootFlags.reconnectDelay, "reconnect-delay", 30*time.Second, "wait this long before the first reconnect attempt")
+	rootCmd.PersistentFlags().DurationVar(&rootFlags.reconnectMaxDelay, "reconnect-max-delay", 30*time.Second, "double the delay after each failed reconnect attempt up to this limit")
 	rootCmd.PersistentFlags().BoolVar(&rootFlags.trace, "trace", false, "trace the TCI communication to the console")
+	rootCmd.PersistentFlags().StringVar(&rootFlags.record, "record", "", "record the TCI communication to this file")
 }
 
 func runWithClient(f func(context.Context, *client.Client, *cobra.Command, []string)) func(*cobra.Command, []string) {
 	return func(cmd *cobra.Command, args []string) {
-		host, err := parseHostArg(rootFlags.hostAddress)
-		if err != nil {
-			log.Fatalf("invalid host address: %v", err)
-		}
-		if host.Port == 0 {
-			host.Port = client.DefaultPort
-			log.Print("using the default port")
-		}
-
 		ctx, cancel := context.WithCancel(context.Background())
 		signals := make(chan os.Signal, 1)
 		signal.Notify(signals, syscall.SIGHUP, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 		go handleCancelation(signals, cancel)
 
+		options := []client.Option{client.WithTrace(rootFlags.trace)}
+		if rootFlags.record != "" {
+			recording, err := os.Create(rootFlags.record)
+			if err != nil {
+				log.Fatalf("cannot create recording: %v", err)
+			}
+			defer recording.Close()
+			options = append(options, client.WithRecorder(client.NewRecorder(recording)))
+		}
+
 		var c *client.Client
+		var err error
 		if rootFlags.reconnect {
-			c = client.KeepOpen(host, 30*time.Second, rootFlags.trace)
+			options = append(options, client.WithReconnectPolicy(reconnectPolicy()))
+			c, err = client.KeepOpenURL(rootFlags.hostAddress, options...)
 		} else {
-			c, err = client.Open(host, rootFlags.trace)
+			c, err = client.OpenURL(rootFlags.hostAddress, options...)
 		}
 		if err != nil {
-			log.Fatalf("cannot conntect to %s: %v", host.String(), err)
+			log.Fatalf("cannot conntect to %s: %v", rootFlags.hostAddress, err)
 		}
 		defer c.Disconnect()
 		if !rootFlags.reconnect {
@@ -78,6 +84,20 @@ func runWithClient(f func(context.Context, *client.Client, *cobra.Command, []str
 	}
 }
 
+// reconnectPolicy returns the reconnect policy configured with the --reconnect-* flags. With the default values, the
+// client retries every 30 seconds.
+func reconnectPolicy() client.ReconnectPolicy {
+	maxDelay := rootFlags.reconnectMaxDelay
+	if maxDelay < rootFlags.reconnectDelay {
+		maxDelay = rootFlags.reconnectDelay
+	}
+	return client.ReconnectPolicy{
+		InitialDelay: rootFlags.reconnectDelay,
+		MaxDelay:     maxDelay,
+		Multiplier:   2,
+	}
+}
+
 func handleCancelation(signals <-chan os.Signal, cancel context.CancelFunc) {
 	count := 0
 	for {
@@ -92,45 +112,3 @@ func handleCancelation(signals <-chan os.Signal, cancel context.CancelFunc) {
 		}
 	}
 }
-
-func parseHostArg(arg string) (*net.TCPAddr, error) {
-	host, port := splitHostPort(arg)
-	if host == "" {
-		host = "localhost"
-	}
-	if port == "" {
-		port = strconv.Itoa(client.DefaultPort)
-	}
-
-	return net.ResolveTCPAddr("tcp", fmt.Sprintf("%s:%s", host, port))
-}
-
-func splitHostPort(hostport string) (host, port string) {
-	host = hostport
-
-	colon := strings.LastIndexByte(host, ':')
-	if colon != -1 && validOptionalPort(host[colon:]) {
-		host, port = host[:colon], host[colon+1:]
-	}
-
-	if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
-		host = host[1 : len(host)-1]
-	}
-
-	return
-}
-
-func validOptionalPort(port string) bool {
-	if port == "" {
-		return true
-	}
-	if port[0] != ':' {
-		return false
-	}
-	for _, b := range port[1:] {
-		if b < '0' || b > '9' {
-			return false
-		}
-	}
-	return true
-}